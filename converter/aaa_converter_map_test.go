@@ -6,10 +6,18 @@ import (
 	"testing"
 )
 
-func TestGetConvertorMap(t *testing.T) {
-	// Reset the map for testing
+// resetConvertorMap empties the map, restoring the registered converters after the test
+// for the fuzz targets and other tests that use GetConvertorMap
+func resetConvertorMap(t *testing.T) {
+	saved := convertorMap
+	t.Cleanup(func() { convertorMap = saved })
 	once = sync.Once{}
 	convertorMap = nil
+}
+
+func TestGetConvertorMap(t *testing.T) {
+	// Reset the map for testing
+	resetConvertorMap(t)
 
 	// Test that getConvertorMap returns a non-nil map
 	result := getConvertorMap()
@@ -30,8 +38,7 @@ func TestGetConvertorMap(t *testing.T) {
 
 func TestGetConvertorMapPublic(t *testing.T) {
 	// Reset the map for testing
-	once = sync.Once{}
-	convertorMap = nil
+	resetConvertorMap(t)
 
 	// Test the public GetConvertorMap function
 	result := GetConvertorMap()
@@ -52,8 +59,7 @@ func TestGetConvertorMapPublic(t *testing.T) {
 
 func TestRegisterConverter(t *testing.T) {
 	// Reset the map for testing
-	once = sync.Once{}
-	convertorMap = nil
+	resetConvertorMap(t)
 
 	// Create a test converter function
 	testConverter := func(value string) (interface{}, error) {
@@ -82,8 +88,7 @@ func TestRegisterConverter(t *testing.T) {
 
 func TestConverterMapConcurrency(t *testing.T) {
 	// Reset the map for testing
-	once = sync.Once{}
-	convertorMap = nil
+	resetConvertorMap(t)
 
 	// Test that multiple goroutines can safely access the map
 	done := make(chan bool, 10)
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// DefaultBigFloatPrecision is the mantissa precision in bits used when BigFloatOptions.Precision is zero
+const DefaultBigFloatPrecision = 256
+
+// BigFloatOptions configures how *big.Float values are parsed
+type BigFloatOptions struct {
+	// Precision is the mantissa precision in bits; zero means DefaultBigFloatPrecision
+	Precision uint
+	// Rounding is applied when the input has more precision than the mantissa can hold
+	Rounding big.RoundingMode
+}
+
+func init() {
+	var bigInt big.Int
+	var bigFloat big.Float
+	var bigRat big.Rat
+
+	registerConverter(reflect.TypeOf(bigInt), StringToBigInt)
+	registerConverter(reflect.TypeOf(&bigInt), StringToBigIntPtr)
+	registerConverter(reflect.TypeOf(bigFloat), StringToBigFloat)
+	registerConverter(reflect.TypeOf(&bigFloat), StringToBigFloatPtr)
+	registerConverter(reflect.TypeOf(bigRat), StringToBigRat)
+	registerConverter(reflect.TypeOf(&bigRat), StringToBigRatPtr)
+}
+
+func StringToBigInt(value string) (interface{}, error) {
+	bigInt, err := parseBigInt(value)
+	if err != nil {
+		return nil, err
+	}
+	return *bigInt, nil
+}
+
+// StringToBigIntPtr parses a decimal integer, or a 0x, 0o or 0b prefixed one, of any size
+func StringToBigIntPtr(value string) (interface{}, error) {
+	bigInt, err := parseBigInt(value)
+	if err != nil {
+		return nil, err
+	}
+	return bigInt, nil
+}
+
+func StringToBigFloat(value string) (interface{}, error) {
+	return NewBigFloatConverter(BigFloatOptions{})(value)
+}
+
+func StringToBigFloatPtr(value string) (interface{}, error) {
+	return NewBigFloatPtrConverter(BigFloatOptions{})(value)
+}
+
+func StringToBigRat(value string) (interface{}, error) {
+	bigRat, err := parseBigRat(value)
+	if err != nil {
+		return nil, err
+	}
+	return *bigRat, nil
+}
+
+// StringToBigRatPtr parses fractions such as "1/3" as well as decimal forms such as "0.25" or "1e-3"
+func StringToBigRatPtr(value string) (interface{}, error) {
+	bigRat, err := parseBigRat(value)
+	if err != nil {
+		return nil, err
+	}
+	return bigRat, nil
+}
+
+// NewBigFloatConverter returns a big.Float converter using the precision and rounding mode in opts
+func NewBigFloatConverter(opts BigFloatOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		bigFloat, err := parseBigFloat(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return *bigFloat, nil
+	}
+}
+
+// NewBigFloatPtrConverter returns a *big.Float converter using the precision and rounding mode in opts
+func NewBigFloatPtrConverter(opts BigFloatOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		bigFloat, err := parseBigFloat(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return bigFloat, nil
+	}
+}
+
+// BigFloatConverters returns big.Float and *big.Float converters built from opts, ready for RegisterAll
+func BigFloatConverters(opts BigFloatOptions) map[reflect.Type]model.ConverterFunc {
+	var bigFloat big.Float
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(bigFloat):  NewBigFloatConverter(opts),
+		reflect.TypeOf(&bigFloat): NewBigFloatPtrConverter(opts),
+	}
+}
+
+func parseBigInt(value string) (*big.Int, error) {
+	// Only use Go literal syntax when a base prefix is present, so "0755" stays decimal
+	base := 10
+	if hasBasePrefix(value) {
+		base = 0
+	}
+	bigInt, ok := new(big.Int).SetString(value, base)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %q", value)
+	}
+	return bigInt, nil
+}
+
+func parseBigFloat(value string, opts BigFloatOptions) (*big.Float, error) {
+	precision := opts.Precision
+	if precision == 0 {
+		precision = DefaultBigFloatPrecision
+	}
+	bigFloat, _, err := big.ParseFloat(value, 0, precision, opts.Rounding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid float %q: %w", value, err)
+	}
+	return bigFloat, nil
+}
+
+func parseBigRat(value string) (*big.Rat, error) {
+	if !exponentInRange(value) {
+		return nil, fmt.Errorf("rational number exponent out of range: %q", value)
+	}
+	bigRat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid rational number: %q", value)
+	}
+	return bigRat, nil
+}
+
+// hasBasePrefix reports whether value, after an optional sign, starts with 0x, 0o or 0b
+func hasBasePrefix(value string) bool {
+	value = strings.TrimLeft(value, "+-")
+	if len(value) < 2 || value[0] != '0' {
+		return false
+	}
+	switch value[1] {
+	case 'x', 'X', 'o', 'O', 'b', 'B':
+		return true
+	}
+	return false
+}
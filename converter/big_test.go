@@ -0,0 +1,190 @@
+package converter
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStringToBigIntPtr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"small", "42", "42", false},
+		{"beyond int64", "123456789012345678901234567890", "123456789012345678901234567890", false},
+		{"negative", "-98765432109876543210", "-98765432109876543210", false},
+		{"plus sign", "+7", "7", false},
+		{"leading zeros stay decimal", "0755", "755", false},
+		{"hex prefix", "0xff", "255", false},
+		{"octal prefix", "0o17", "15", false},
+		{"binary prefix", "-0b101", "-5", false},
+		{"underscores with prefix", "0x_ff_ff", "65535", false},
+		{"decimal point", "1.5", "", true},
+		{"invalid digit", "12a", "", true},
+		{"empty string", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToBigIntPtr(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToBigIntPtr(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToBigIntPtr(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToBigIntPtr(%q) unexpected error: %v", tt.input, err)
+				}
+				if got := result.(*big.Int).String(); got != tt.expected {
+					t.Errorf("StringToBigIntPtr(%q) = %s, expected %s", tt.input, got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToBigInt(t *testing.T) {
+	result, err := StringToBigInt("18446744073709551616")
+	if err != nil {
+		t.Fatalf("StringToBigInt unexpected error: %v", err)
+	}
+	bigInt := result.(big.Int)
+	if bigInt.String() != "18446744073709551616" {
+		t.Errorf("StringToBigInt = %s, expected 18446744073709551616", bigInt.String())
+	}
+}
+
+func TestStringToBigFloatPtr(t *testing.T) {
+	result, err := StringToBigFloatPtr("3.14159265358979323846264338327950288419716939937510")
+	if err != nil {
+		t.Fatalf("StringToBigFloatPtr unexpected error: %v", err)
+	}
+	bigFloat := result.(*big.Float)
+	if bigFloat.Prec() != DefaultBigFloatPrecision {
+		t.Errorf("precision = %d, expected %d", bigFloat.Prec(), DefaultBigFloatPrecision)
+	}
+	if got := bigFloat.Text('f', 40); got != "3.1415926535897932384626433832795028841972" {
+		t.Errorf("StringToBigFloatPtr lost precision: %s", got)
+	}
+
+	for _, input := range []string{"0x1p-2", "1_000.5", "-2.5e10", "Inf"} {
+		if _, err := StringToBigFloatPtr(input); err != nil {
+			t.Errorf("StringToBigFloatPtr(%q) unexpected error: %v", input, err)
+		}
+	}
+	for _, input := range []string{"", "abc", "1.2.3", "NaN"} {
+		if result, err := StringToBigFloatPtr(input); err == nil || result != nil {
+			t.Errorf("StringToBigFloatPtr(%q) = %v, %v; expected nil result and error", input, result, err)
+		}
+	}
+}
+
+func TestNewBigFloatConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     BigFloatOptions
+		input    string
+		expected string
+	}{
+		{"nearest even", BigFloatOptions{Precision: 4}, "9.5", "10"},
+		{"toward zero", BigFloatOptions{Precision: 4, Rounding: big.ToZero}, "9.5", "9"},
+		{"away from zero", BigFloatOptions{Precision: 4, Rounding: big.AwayFromZero}, "8.5", "9"},
+		{"toward negative infinity", BigFloatOptions{Precision: 4, Rounding: big.ToNegativeInf}, "-8.5", "-9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewBigFloatConverter(tt.opts)(tt.input)
+			if err != nil {
+				t.Fatalf("NewBigFloatConverter(%q) unexpected error: %v", tt.input, err)
+			}
+			bigFloat := result.(big.Float)
+			if bigFloat.Prec() != tt.opts.Precision {
+				t.Errorf("precision = %d, expected %d", bigFloat.Prec(), tt.opts.Precision)
+			}
+			if got := bigFloat.Text('f', 0); got != tt.expected {
+				t.Errorf("NewBigFloatConverter(%q) = %s, expected %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBigFloatConverters(t *testing.T) {
+	var bigFloat big.Float
+	converters := BigFloatConverters(BigFloatOptions{Precision: 32})
+
+	result, err := converters[reflect.TypeOf(&bigFloat)]("1.5")
+	if err != nil || result.(*big.Float).Prec() != 32 {
+		t.Errorf("*big.Float converter returned %v, %v", result, err)
+	}
+	if _, ok := converters[reflect.TypeOf(bigFloat)]; !ok {
+		t.Error("big.Float converter missing")
+	}
+}
+
+func TestStringToBigRatPtr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"fraction", "1/3", "1/3", false},
+		{"reduced fraction", "6/8", "3/4", false},
+		{"negative fraction", "-2/4", "-1/2", false},
+		{"decimal", "0.25", "1/4", false},
+		{"exponent", "1e-3", "1/1000", false},
+		{"integer", "7", "7/1", false},
+		{"larger exponent than decimal", "1e10000", "1" + strings.Repeat("0", 10000) + "/1", false},
+		{"largest exponent", "1e-100000", "1/1" + strings.Repeat("0", 100000), false},
+		{"hex exponent", "0x1p-2", "1/4", false},
+		{"largest hex exponent", "0x1p100000", new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 100000)).String(), false},
+		{"zero denominator", "1/0", "", true},
+		{"huge exponent", "1e100001", "", true},
+		{"overflowing exponent", "1e99999999999999999999", "", true},
+		{"huge hex exponent", "0x1p-100001", "", true},
+		{"invalid", "one third", "", true},
+		{"empty string", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToBigRatPtr(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToBigRatPtr(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToBigRatPtr(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToBigRatPtr(%q) unexpected error: %v", tt.input, err)
+				}
+				if got := result.(*big.Rat).String(); got != tt.expected {
+					t.Errorf("StringToBigRatPtr(%q) = %s, expected %s", tt.input, got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToBigRat(t *testing.T) {
+	result, err := StringToBigRat("22/7")
+	if err != nil {
+		t.Fatalf("StringToBigRat unexpected error: %v", err)
+	}
+	bigRat := result.(big.Rat)
+	if bigRat.String() != "22/7" {
+		t.Errorf("StringToBigRat = %s, expected 22/7", bigRat.String())
+	}
+}
@@ -0,0 +1,99 @@
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// BoolOptions configures the tokens accepted by a bool converter
+type BoolOptions struct {
+	// TrueTokens are the inputs that convert to true
+	TrueTokens []string
+	// FalseTokens are the inputs that convert to false
+	FalseTokens []string
+	// CaseSensitive requires inputs to match a token exactly
+	CaseSensitive bool
+	// EmptyIsFalse converts the empty string to false instead of failing
+	EmptyIsFalse bool
+}
+
+// DefaultBoolOptions returns the extended vocabulary commonly found in configs and env vars
+func DefaultBoolOptions() BoolOptions {
+	return BoolOptions{
+		TrueTokens:  []string{"true", "t", "1", "yes", "y", "on", "enabled", "enable"},
+		FalseTokens: []string{"false", "f", "0", "no", "n", "off", "disabled", "disable"},
+	}
+}
+
+// NewBoolConverter returns a bool converter that accepts the tokens configured in opts
+func NewBoolConverter(opts BoolOptions) model.ConverterFunc {
+	set := newBoolSet(opts)
+	return func(value string) (interface{}, error) {
+		return set.parse(value)
+	}
+}
+
+// NewBoolPtrConverter returns a *bool converter that accepts the tokens configured in opts
+func NewBoolPtrConverter(opts BoolOptions) model.ConverterFunc {
+	set := newBoolSet(opts)
+	return func(value string) (interface{}, error) {
+		boolValue, err := set.parse(value)
+		if err != nil {
+			return nil, err
+		}
+		return &boolValue, nil
+	}
+}
+
+// BoolConverters returns bool and *bool converters built from opts, ready for RegisterAll
+func BoolConverters(opts BoolOptions) map[reflect.Type]model.ConverterFunc {
+	var boolVar bool
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(boolVar):  NewBoolConverter(opts),
+		reflect.TypeOf(&boolVar): NewBoolPtrConverter(opts),
+	}
+}
+
+type boolSet struct {
+	tokens        map[string]bool
+	caseSensitive bool
+	emptyIsFalse  bool
+}
+
+func newBoolSet(opts BoolOptions) *boolSet {
+	set := &boolSet{
+		tokens:        make(map[string]bool, len(opts.TrueTokens)+len(opts.FalseTokens)),
+		caseSensitive: opts.CaseSensitive,
+		emptyIsFalse:  opts.EmptyIsFalse,
+	}
+	// False tokens are added last so that a token listed in both sets resolves to false
+	for _, token := range opts.TrueTokens {
+		set.tokens[set.normalize(token)] = true
+	}
+	for _, token := range opts.FalseTokens {
+		set.tokens[set.normalize(token)] = false
+	}
+	return set
+}
+
+func (s *boolSet) normalize(token string) string {
+	token = strings.TrimSpace(token)
+	if !s.caseSensitive {
+		token = strings.ToLower(token)
+	}
+	return token
+}
+
+func (s *boolSet) parse(value string) (bool, error) {
+	key := s.normalize(value)
+	if key == "" && s.emptyIsFalse {
+		return false, nil
+	}
+	if boolValue, ok := s.tokens[key]; ok {
+		return boolValue, nil
+	}
+	return false, fmt.Errorf("invalid boolean value: %q", value)
+}
@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewBoolConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     BoolOptions
+		input    string
+		expected bool
+		hasError bool
+	}{
+		{"yes value", DefaultBoolOptions(), "yes", true, false},
+		{"YES value", DefaultBoolOptions(), "YES", true, false},
+		{"on value", DefaultBoolOptions(), "on", true, false},
+		{"enabled value", DefaultBoolOptions(), "Enabled", true, false},
+		{"y value", DefaultBoolOptions(), "y", true, false},
+		{"off value", DefaultBoolOptions(), "off", false, false},
+		{"no value", DefaultBoolOptions(), "no", false, false},
+		{"padded value", DefaultBoolOptions(), " on ", true, false},
+		{"invalid value", DefaultBoolOptions(), "maybe", false, true},
+		{"empty string", DefaultBoolOptions(), "", false, true},
+		{"empty string as false", BoolOptions{TrueTokens: []string{"on"}, EmptyIsFalse: true}, "", false, false},
+		{"case sensitive match", BoolOptions{TrueTokens: []string{"Y"}, FalseTokens: []string{"N"}, CaseSensitive: true}, "Y", true, false},
+		{"case sensitive mismatch", BoolOptions{TrueTokens: []string{"Y"}, FalseTokens: []string{"N"}, CaseSensitive: true}, "y", false, true},
+		{"custom tokens", BoolOptions{TrueTokens: []string{"ja"}, FalseTokens: []string{"nein"}}, "NEIN", false, false},
+		{"default token not in custom set", BoolOptions{TrueTokens: []string{"ja"}}, "true", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewBoolConverter(tt.opts)(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("NewBoolConverter(%q) expected error, got nil", tt.input)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("NewBoolConverter(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("NewBoolConverter(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewBoolPtrConverter(t *testing.T) {
+	convert := NewBoolPtrConverter(DefaultBoolOptions())
+
+	result, err := convert("on")
+	if err != nil {
+		t.Fatalf("NewBoolPtrConverter(%q) unexpected error: %v", "on", err)
+	}
+	if boolResult := result.(*bool); !*boolResult {
+		t.Errorf("NewBoolPtrConverter(%q) = %v, expected true", "on", *boolResult)
+	}
+
+	result, err = convert("sometimes")
+	if err == nil {
+		t.Errorf("NewBoolPtrConverter(%q) expected error, got nil", "sometimes")
+	}
+	if result != nil {
+		t.Errorf("NewBoolPtrConverter(%q) expected nil result, got %v", "sometimes", result)
+	}
+}
+
+func TestBoolConverters(t *testing.T) {
+	var boolVar bool
+	converters := BoolConverters(DefaultBoolOptions())
+
+	if len(converters) != 2 {
+		t.Fatalf("expected 2 converters, got %d", len(converters))
+	}
+
+	result, err := converters[reflect.TypeOf(boolVar)]("disabled")
+	if err != nil || result != false {
+		t.Errorf("bool converter returned %v, %v; expected false, nil", result, err)
+	}
+
+	result, err = converters[reflect.TypeOf(&boolVar)]("enabled")
+	if err != nil || !*result.(*bool) {
+		t.Errorf("*bool converter returned %v, %v; expected true, nil", result, err)
+	}
+}
@@ -0,0 +1,147 @@
+package converter
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// ByteEncoding selects how a string is decoded into bytes
+type ByteEncoding int
+
+const (
+	// EncodingRaw uses the bytes of the string as-is
+	EncodingRaw ByteEncoding = iota
+	// EncodingHex decodes hexadecimal, with an optional "0x" prefix
+	EncodingHex
+	// EncodingBase64 decodes padded standard base64
+	EncodingBase64
+	// EncodingBase64Raw decodes unpadded standard base64
+	EncodingBase64Raw
+	// EncodingBase64URL decodes padded URL-safe base64
+	EncodingBase64URL
+	// EncodingBase64RawURL decodes unpadded URL-safe base64
+	EncodingBase64RawURL
+)
+
+// Prefixes recognized when BytesOptions.DetectPrefix is set.
+// Base64 input behind a prefix may be padded or not.
+const (
+	RawPrefix       = "raw:"
+	HexPrefix       = "hex:"
+	Base64Prefix    = "base64:"
+	Base64URLPrefix = "base64url:"
+)
+
+// BytesOptions configures a byte slice or byte array converter
+type BytesOptions struct {
+	// Encoding is used when no prefix is detected
+	Encoding ByteEncoding
+	// DetectPrefix lets inputs select their encoding with a prefix such as "hex:" or "base64:"
+	DetectPrefix bool
+}
+
+func init() {
+	registerConverter(reflect.TypeOf([]byte{}), StringToBytes)
+}
+
+// StringToBytes returns the raw bytes of value unless it starts with an encoding prefix
+func StringToBytes(value string) (interface{}, error) {
+	return NewBytesConverter(BytesOptions{Encoding: EncodingRaw, DetectPrefix: true})(value)
+}
+
+// NewBytesConverter returns a []byte converter using opts
+func NewBytesConverter(opts BytesOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		data, err := decodeBytes(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+// NewByteArrayConverter returns a converter for a fixed-size byte array type such as [32]byte.
+// The decoded input must have exactly the length of the array.
+func NewByteArrayConverter(arrayType reflect.Type, opts BytesOptions) (model.ConverterFunc, error) {
+	if arrayType.Kind() != reflect.Array || arrayType.Elem().Kind() != reflect.Uint8 {
+		return nil, fmt.Errorf("%s is not a byte array type", arrayType)
+	}
+
+	return func(value string) (interface{}, error) {
+		data, err := decodeBytes(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != arrayType.Len() {
+			return nil, fmt.Errorf("invalid length for %s: expected %d bytes, got %d", arrayType, arrayType.Len(), len(data))
+		}
+		array := reflect.New(arrayType).Elem()
+		reflect.Copy(array, reflect.ValueOf(data))
+		return array.Interface(), nil
+	}, nil
+}
+
+func decodeBytes(value string, opts BytesOptions) ([]byte, error) {
+	encoding := opts.Encoding
+	if opts.DetectPrefix {
+		switch {
+		case strings.HasPrefix(value, RawPrefix):
+			return []byte(value[len(RawPrefix):]), nil
+		case strings.HasPrefix(value, HexPrefix):
+			value, encoding = value[len(HexPrefix):], EncodingHex
+		case strings.HasPrefix(value, Base64Prefix):
+			value, encoding = strings.TrimRight(value[len(Base64Prefix):], "="), EncodingBase64Raw
+		case strings.HasPrefix(value, Base64URLPrefix):
+			value, encoding = strings.TrimRight(value[len(Base64URLPrefix):], "="), EncodingBase64RawURL
+		}
+	}
+
+	var data []byte
+	var err error
+	switch encoding {
+	case EncodingRaw:
+		return []byte(value), nil
+	case EncodingHex:
+		if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+			value = value[2:]
+		}
+		data, err = hex.DecodeString(value)
+	case EncodingBase64:
+		data, err = base64.StdEncoding.DecodeString(value)
+	case EncodingBase64Raw:
+		data, err = base64.RawStdEncoding.DecodeString(value)
+	case EncodingBase64URL:
+		data, err = base64.URLEncoding.DecodeString(value)
+	case EncodingBase64RawURL:
+		data, err = base64.RawURLEncoding.DecodeString(value)
+	default:
+		return nil, fmt.Errorf("unknown byte encoding: %d", encoding)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s data: %w", encoding, err)
+	}
+	return data, nil
+}
+
+func (e ByteEncoding) String() string {
+	switch e {
+	case EncodingRaw:
+		return "raw"
+	case EncodingHex:
+		return "hex"
+	case EncodingBase64:
+		return "base64"
+	case EncodingBase64Raw:
+		return "unpadded base64"
+	case EncodingBase64URL:
+		return "base64url"
+	case EncodingBase64RawURL:
+		return "unpadded base64url"
+	}
+	return fmt.Sprintf("ByteEncoding(%d)", int(e))
+}
@@ -0,0 +1,123 @@
+package converter
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestStringToBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []byte
+		hasError bool
+	}{
+		{"raw text", "hello", []byte("hello"), false},
+		{"empty string", "", []byte{}, false},
+		{"raw prefix", "raw:hex:abc", []byte("hex:abc"), false},
+		{"hex prefix", "hex:deadbeef", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"hex prefix with 0x", "hex:0xCAFE", []byte{0xca, 0xfe}, false},
+		{"base64 prefix padded", "base64:aGk=", []byte("hi"), false},
+		{"base64 prefix unpadded", "base64:aGk", []byte("hi"), false},
+		{"base64url prefix", "base64url:-_8", []byte{0xfb, 0xff}, false},
+		{"invalid hex", "hex:xyz", nil, true},
+		{"odd length hex", "hex:abc", nil, true},
+		{"invalid base64", "base64:***", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToBytes(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToBytes(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToBytes(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToBytes(%q) unexpected error: %v", tt.input, err)
+				}
+				if !bytes.Equal(result.([]byte), tt.expected) {
+					t.Errorf("StringToBytes(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewBytesConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     BytesOptions
+		input    string
+		expected []byte
+		hasError bool
+	}{
+		{"hex", BytesOptions{Encoding: EncodingHex}, "00ff", []byte{0x00, 0xff}, false},
+		{"base64 padded", BytesOptions{Encoding: EncodingBase64}, "aGk=", []byte("hi"), false},
+		{"base64 padding required", BytesOptions{Encoding: EncodingBase64}, "aGk", nil, true},
+		{"base64 unpadded", BytesOptions{Encoding: EncodingBase64Raw}, "aGk", []byte("hi"), false},
+		{"base64 padding rejected", BytesOptions{Encoding: EncodingBase64Raw}, "aGk=", nil, true},
+		{"base64url padded", BytesOptions{Encoding: EncodingBase64URL}, "-_8=", []byte{0xfb, 0xff}, false},
+		{"base64url unpadded", BytesOptions{Encoding: EncodingBase64RawURL}, "-_8", []byte{0xfb, 0xff}, false},
+		{"prefix ignored without detection", BytesOptions{Encoding: EncodingRaw}, "hex:00", []byte("hex:00"), false},
+		{"prefix overrides encoding", BytesOptions{Encoding: EncodingHex, DetectPrefix: true}, "base64:aGk", []byte("hi"), false},
+		{"unknown encoding", BytesOptions{Encoding: ByteEncoding(99)}, "00", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewBytesConverter(tt.opts)(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("NewBytesConverter(%q) expected error, got nil", tt.input)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("NewBytesConverter(%q) unexpected error: %v", tt.input, err)
+				}
+				if !bytes.Equal(result.([]byte), tt.expected) {
+					t.Errorf("NewBytesConverter(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewByteArrayConverter(t *testing.T) {
+	convert, err := NewByteArrayConverter(reflect.TypeOf([4]byte{}), BytesOptions{Encoding: EncodingHex, DetectPrefix: true})
+	if err != nil {
+		t.Fatalf("NewByteArrayConverter unexpected error: %v", err)
+	}
+
+	result, err := convert("01020304")
+	if err != nil {
+		t.Fatalf("convert unexpected error: %v", err)
+	}
+	if result != [4]byte{1, 2, 3, 4} {
+		t.Errorf("convert = %v, expected [1 2 3 4]", result)
+	}
+
+	result, err = convert("base64:AQIDBA")
+	if err != nil || result != [4]byte{1, 2, 3, 4} {
+		t.Errorf("convert with prefix = %v, %v; expected [1 2 3 4]", result, err)
+	}
+
+	for _, input := range []string{"010203", "0102030405", "zz"} {
+		if result, err := convert(input); err == nil || result != nil {
+			t.Errorf("convert(%q) = %v, %v; expected nil result and error", input, result, err)
+		}
+	}
+
+	if _, err := NewByteArrayConverter(reflect.TypeOf([]byte{}), BytesOptions{}); err == nil {
+		t.Error("NewByteArrayConverter should reject slice types")
+	}
+	if _, err := NewByteArrayConverter(reflect.TypeOf([4]int{}), BytesOptions{}); err == nil {
+		t.Error("NewByteArrayConverter should reject non-byte arrays")
+	}
+}
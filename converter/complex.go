@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// ComplexOptions configures how complex numbers are parsed
+type ComplexOptions struct {
+	// AllowPair also accepts "real,imag" pairs such as "1.5,-2" or "(1.5, -2)"
+	AllowPair bool
+}
+
+func init() {
+	registerConverter(reflect.TypeOf(complex64(0)), StringToComplex64)
+	registerConverter(reflect.TypeOf(complex128(0)), StringToComplex128)
+}
+
+func StringToComplex64(value string) (interface{}, error) {
+	c, err := parseComplex(value, 64, ComplexOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return complex64(c), nil
+}
+
+func StringToComplex128(value string) (interface{}, error) {
+	c, err := parseComplex(value, 128, ComplexOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewComplexConverter returns a complex64 or complex128 converter, selected by bitSize, using opts
+func NewComplexConverter(bitSize int, opts ComplexOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		c, err := parseComplex(value, bitSize, opts)
+		if err != nil {
+			return nil, err
+		}
+		if bitSize == 64 {
+			return complex64(c), nil
+		}
+		return c, nil
+	}
+}
+
+// ComplexConverters returns complex64, complex128 and pointer converters built from opts, ready for RegisterAll
+func ComplexConverters(opts ComplexOptions) map[reflect.Type]model.ConverterFunc {
+	var complex64Var complex64
+	var complex128Var complex128
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(complex64Var):   NewComplexConverter(64, opts),
+		reflect.TypeOf(complex128Var):  NewComplexConverter(128, opts),
+		reflect.TypeOf(&complex64Var):  NewComplexPtrConverter(64, opts),
+		reflect.TypeOf(&complex128Var): NewComplexPtrConverter(128, opts),
+	}
+}
+
+func parseComplex(value string, bitSize int, opts ComplexOptions) (complex128, error) {
+	if opts.AllowPair {
+		if c, ok, err := parseComplexPair(value, bitSize); ok {
+			return c, err
+		}
+	}
+	return strconv.ParseComplex(value, bitSize)
+}
+
+// parseComplexPair parses "real,imag" with optional parentheses; ok is false when value is not a pair
+func parseComplexPair(value string, bitSize int) (c complex128, ok bool, err error) {
+	text := strings.TrimSpace(value)
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		text = text[1 : len(text)-1]
+	}
+	realText, imagText, found := strings.Cut(text, ",")
+	if !found {
+		return 0, false, nil
+	}
+
+	// Each part is parsed at the precision of one component of the complex type
+	partSize := bitSize / 2
+	realPart, err := strconv.ParseFloat(strings.TrimSpace(realText), partSize)
+	if err != nil {
+		return 0, true, fmt.Errorf("invalid real part in %q: %w", value, err)
+	}
+	imagPart, err := strconv.ParseFloat(strings.TrimSpace(imagText), partSize)
+	if err != nil {
+		return 0, true, fmt.Errorf("invalid imaginary part in %q: %w", value, err)
+	}
+	return complex(realPart, imagPart), true, nil
+}
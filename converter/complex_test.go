@@ -0,0 +1,127 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringToComplex128(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected complex128
+		hasError bool
+	}{
+		{"full form", "1+2i", complex(1, 2), false},
+		{"parenthesized", "(1.5-2.5i)", complex(1.5, -2.5), false},
+		{"real only", "3", complex(3, 0), false},
+		{"imaginary only", "-4i", complex(0, -4), false},
+		{"exponent", "1e2+1e-2i", complex(100, 0.01), false},
+		{"pair rejected by default", "1,2", 0, true},
+		{"invalid", "one+twoi", 0, true},
+		{"empty string", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToComplex128(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToComplex128(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToComplex128(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToComplex128(%q) unexpected error: %v", tt.input, err)
+				}
+				if result.(complex128) != tt.expected {
+					t.Errorf("StringToComplex128(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToComplex64(t *testing.T) {
+	result, err := StringToComplex64("0.1+0.2i")
+	if err != nil {
+		t.Fatalf("StringToComplex64 unexpected error: %v", err)
+	}
+	if result.(complex64) != complex(float32(0.1), float32(0.2)) {
+		t.Errorf("StringToComplex64 = %v, expected (0.1+0.2i)", result)
+	}
+
+	if _, err := StringToComplex64("1e40+0i"); err == nil {
+		t.Error("StringToComplex64 should reject components out of float32 range")
+	}
+	if _, err := StringToComplex128("1e40+0i"); err != nil {
+		t.Errorf("StringToComplex128 unexpected error: %v", err)
+	}
+}
+
+func TestNewComplexConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected complex128
+		hasError bool
+	}{
+		{"pair", "1.5,-2", complex(1.5, -2), false},
+		{"pair with spaces", " 3 , 4 ", complex(3, 4), false},
+		{"parenthesized pair", "(0, 1e3)", complex(0, 1000), false},
+		{"standard form still accepted", "1+2i", complex(1, 2), false},
+		{"bad real part", "x,1", 0, true},
+		{"bad imaginary part", "1,", 0, true},
+		{"too many parts", "1,2,3", 0, true},
+	}
+
+	convert := NewComplexConverter(128, ComplexOptions{AllowPair: true})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convert(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("NewComplexConverter(%q) expected error, got nil", tt.input)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("NewComplexConverter(%q) unexpected error: %v", tt.input, err)
+				}
+				if result.(complex128) != tt.expected {
+					t.Errorf("NewComplexConverter(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	result, err := NewComplexConverter(64, ComplexOptions{AllowPair: true})("2,3")
+	if err != nil || result.(complex64) != complex64(complex(2, 3)) {
+		t.Errorf("complex64 pair converter returned %v, %v", result, err)
+	}
+	if _, err := NewComplexConverter(64, ComplexOptions{AllowPair: true})("1e40,0"); err == nil {
+		t.Error("complex64 pair converter should reject components out of float32 range")
+	}
+}
+
+func TestComplexConverters(t *testing.T) {
+	var complex64Var complex64
+	var complex128Var complex128
+	converters := ComplexConverters(ComplexOptions{AllowPair: true})
+
+	if len(converters) != 4 {
+		t.Fatalf("expected 4 converters, got %d", len(converters))
+	}
+
+	result, err := converters[reflect.TypeOf(&complex64Var)]("1,1")
+	if err != nil || *result.(*complex64) != complex64(complex(1, 1)) {
+		t.Errorf("*complex64 converter returned %v, %v", result, err)
+	}
+	result, err = converters[reflect.TypeOf(&complex128Var)]("1,1")
+	if err != nil || *result.(*complex128) != complex(1, 1) {
+		t.Errorf("*complex128 converter returned %v, %v", result, err)
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"reflect"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+func init() {
+	var complex64Var complex64
+	var complex128Var complex128
+
+	registerConverter(reflect.TypeOf(&complex64Var), StringToComplex64Ptr)
+	registerConverter(reflect.TypeOf(&complex128Var), StringToComplex128Ptr)
+}
+
+func StringToComplex64Ptr(value string) (interface{}, error) {
+	return NewComplexPtrConverter(64, ComplexOptions{})(value)
+}
+
+func StringToComplex128Ptr(value string) (interface{}, error) {
+	return NewComplexPtrConverter(128, ComplexOptions{})(value)
+}
+
+// NewComplexPtrConverter returns a *complex64 or *complex128 converter, selected by bitSize, using opts
+func NewComplexPtrConverter(bitSize int, opts ComplexOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		c, err := parseComplex(value, bitSize, opts)
+		if err != nil {
+			return nil, err
+		}
+		if bitSize == 64 {
+			complexValue := complex64(c)
+			return &complexValue, nil
+		}
+		return &c, nil
+	}
+}
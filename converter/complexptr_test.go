@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"testing"
+)
+
+func TestStringToComplex64Ptr(t *testing.T) {
+	result, err := StringToComplex64Ptr("1-1i")
+	if err != nil {
+		t.Fatalf("StringToComplex64Ptr unexpected error: %v", err)
+	}
+	if *result.(*complex64) != complex64(complex(1, -1)) {
+		t.Errorf("StringToComplex64Ptr = %v, expected (1-1i)", *result.(*complex64))
+	}
+
+	if result, err := StringToComplex64Ptr("i1"); err == nil || result != nil {
+		t.Errorf("StringToComplex64Ptr expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestStringToComplex128Ptr(t *testing.T) {
+	result, err := StringToComplex128Ptr("2.5i")
+	if err != nil {
+		t.Fatalf("StringToComplex128Ptr unexpected error: %v", err)
+	}
+	if *result.(*complex128) != complex(0, 2.5) {
+		t.Errorf("StringToComplex128Ptr = %v, expected (0+2.5i)", *result.(*complex128))
+	}
+
+	if result, err := StringToComplex128Ptr(""); err == nil || result != nil {
+		t.Errorf("StringToComplex128Ptr expected nil result and error, got %v, %v", result, err)
+	}
+}
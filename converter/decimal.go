@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"reflect"
+
+	"github.com/dheeraj-sn/str2go/decimal"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// DecimalOptions configures how decimal.Decimal values are parsed
+type DecimalOptions struct {
+	// FixedScale rounds or pads every value to Scale digits after the decimal point
+	FixedScale bool
+	// Scale is the number of digits after the decimal point when FixedScale is set
+	Scale int
+	// Rounding is used when FixedScale removes digits
+	Rounding decimal.RoundingMode
+}
+
+func init() {
+	var decimalVar decimal.Decimal
+	registerConverter(reflect.TypeOf(decimalVar), StringToDecimal)
+	registerConverter(reflect.TypeOf(&decimalVar), StringToDecimalPtr)
+}
+
+// StringToDecimal parses value exactly, keeping its digits and scale
+func StringToDecimal(value string) (interface{}, error) {
+	return NewDecimalConverter(DecimalOptions{})(value)
+}
+
+func StringToDecimalPtr(value string) (interface{}, error) {
+	return NewDecimalPtrConverter(DecimalOptions{})(value)
+}
+
+// NewDecimalConverter returns a decimal.Decimal converter using opts
+func NewDecimalConverter(opts DecimalOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		d, err := parseDecimal(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+}
+
+// NewDecimalPtrConverter returns a *decimal.Decimal converter using opts
+func NewDecimalPtrConverter(opts DecimalOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		d, err := parseDecimal(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &d, nil
+	}
+}
+
+// DecimalConverters returns decimal.Decimal and *decimal.Decimal converters built from opts, ready for RegisterAll
+func DecimalConverters(opts DecimalOptions) map[reflect.Type]model.ConverterFunc {
+	var decimalVar decimal.Decimal
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(decimalVar):  NewDecimalConverter(opts),
+		reflect.TypeOf(&decimalVar): NewDecimalPtrConverter(opts),
+	}
+}
+
+func parseDecimal(value string, opts DecimalOptions) (decimal.Decimal, error) {
+	d, err := decimal.Parse(value)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if opts.FixedScale {
+		return d.Round(opts.Scale, opts.Rounding)
+	}
+	return d, nil
+}
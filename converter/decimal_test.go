@@ -0,0 +1,106 @@
+package converter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/decimal"
+)
+
+func TestStringToDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"price", "19.99", "19.99", false},
+		{"keeps scale", "10.50", "10.50", false},
+		{"negative", "-0.01", "-0.01", false},
+		{"exact tenth", "0.1", "0.1", false},
+		{"overflow", "123456789012345678901", "", true},
+		{"invalid", "ten", "", true},
+		{"empty string", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToDecimal(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToDecimal(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToDecimal(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToDecimal(%q) unexpected error: %v", tt.input, err)
+				}
+				if got := result.(decimal.Decimal).String(); got != tt.expected {
+					t.Errorf("StringToDecimal(%q) = %s, expected %s", tt.input, got, tt.expected)
+				}
+			}
+		})
+	}
+
+	if _, err := StringToDecimal("123456789012345678901"); !errors.Is(err, decimal.ErrOverflow) {
+		t.Errorf("StringToDecimal should report decimal.ErrOverflow, got %v", err)
+	}
+}
+
+func TestStringToDecimalPtr(t *testing.T) {
+	result, err := StringToDecimalPtr("3.50")
+	if err != nil {
+		t.Fatalf("StringToDecimalPtr unexpected error: %v", err)
+	}
+	if d := result.(*decimal.Decimal); d.String() != "3.50" {
+		t.Errorf("StringToDecimalPtr = %s, expected 3.50", d)
+	}
+
+	if result, err := StringToDecimalPtr("3,50"); err == nil || result != nil {
+		t.Errorf("StringToDecimalPtr expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestNewDecimalConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     DecimalOptions
+		input    string
+		expected string
+	}{
+		{"scale preserved without fixed scale", DecimalOptions{}, "1.005", "1.005"},
+		{"padded to fixed scale", DecimalOptions{FixedScale: true, Scale: 2}, "7", "7.00"},
+		{"half even", DecimalOptions{FixedScale: true, Scale: 2}, "1.005", "1.00"},
+		{"half up", DecimalOptions{FixedScale: true, Scale: 2, Rounding: decimal.RoundHalfUp}, "1.005", "1.01"},
+		{"truncate", DecimalOptions{FixedScale: true, Scale: 0, Rounding: decimal.RoundDown}, "-9.99", "-9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewDecimalConverter(tt.opts)(tt.input)
+			if err != nil {
+				t.Fatalf("NewDecimalConverter(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := result.(decimal.Decimal).String(); got != tt.expected {
+				t.Errorf("NewDecimalConverter(%q) = %s, expected %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecimalConverters(t *testing.T) {
+	var decimalVar decimal.Decimal
+	converters := DecimalConverters(DecimalOptions{FixedScale: true, Scale: 2})
+
+	result, err := converters[reflect.TypeOf(&decimalVar)]("4.5")
+	if err != nil || result.(*decimal.Decimal).String() != "4.50" {
+		t.Errorf("*decimal.Decimal converter returned %v, %v", result, err)
+	}
+	if _, ok := converters[reflect.TypeOf(decimalVar)]; !ok {
+		t.Error("decimal.Decimal converter missing")
+	}
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"reflect"
+	"time"
+)
+
+func init() {
+	registerConverter(reflect.TypeOf(time.Duration(0)), StringToDuration)
+	registerConverter(reflect.TypeOf(new(time.Duration)), StringToDurationPtr)
+}
+
+// StringToDuration parses Go duration strings such as "1h30m", "250ms" or "-1.5s"
+func StringToDuration(value string) (interface{}, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return duration, nil
+}
+
+func StringToDurationPtr(value string) (interface{}, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStringToDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+		hasError bool
+	}{
+		{"hours and minutes", "1h30m", 90 * time.Minute, false},
+		{"milliseconds", "250ms", 250 * time.Millisecond, false},
+		{"fractional negative", "-1.5s", -1500 * time.Millisecond, false},
+		{"microseconds", "3µs", 3 * time.Microsecond, false},
+		{"zero", "0", 0, false},
+		{"missing unit", "10", 0, true},
+		{"unknown unit", "1d", 0, true},
+		{"overflow", "9999999h", 0, true},
+		{"empty string", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToDuration(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToDuration(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToDuration(%q) expected nil result, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("StringToDuration(%q) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("StringToDuration(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringToDurationPtr(t *testing.T) {
+	result, err := StringToDurationPtr("2m")
+	if err != nil {
+		t.Fatalf("StringToDurationPtr(%q) unexpected error: %v", "2m", err)
+	}
+	if d := result.(*time.Duration); *d != 2*time.Minute {
+		t.Errorf("StringToDurationPtr(%q) = %v, expected %v", "2m", *d, 2*time.Minute)
+	}
+
+	result, err = StringToDurationPtr("soon")
+	if err == nil {
+		t.Errorf("StringToDurationPtr(%q) expected error, got nil", "soon")
+	}
+	if result != nil {
+		t.Errorf("StringToDurationPtr(%q) expected nil result, got %v", "soon", result)
+	}
+}
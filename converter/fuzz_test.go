@@ -0,0 +1,276 @@
+package converter_test
+
+import (
+	"database/sql"
+	"math"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/decimal"
+	"github.com/dheeraj-sn/str2go/model"
+	"github.com/dheeraj-sn/str2go/str2gotest"
+)
+
+// fuzzCase is a converter checked by a fuzz target. Results must have the documented type,
+// which is wider than the target for the legacy integer and float32 converters, must survive
+// formatting and converting again, and failures must not panic.
+type fuzzCase struct {
+	convert model.ConverterFunc
+	target  interface{}
+	result  interface{}
+	equal   func(a, b interface{}) bool
+}
+
+// fuzzConverters checks every case against each fuzzed input; seeds are read from
+// testdata/fuzz/<target name>
+func fuzzConverters(f *testing.F, cases []fuzzCase) {
+	f.Fuzz(func(t *testing.T, input string) {
+		for _, c := range cases {
+			opts := str2gotest.Options{Equal: c.equal}
+			if c.result != nil {
+				opts.ResultType = reflect.TypeOf(c.result)
+			}
+			str2gotest.CheckInput(t, c.convert, reflect.TypeOf(c.target), opts, input)
+		}
+	})
+}
+
+// sameText compares values by their String method, for types such as *url.URL and
+// *regexp.Regexp whose parsed form keeps details that formatting does not preserve
+func sameText(a, b interface{}) bool {
+	return a.(interface{ String() string }).String() == b.(interface{ String() string }).String()
+}
+
+func sameRegexp(a, b interface{}) bool {
+	ra, rb := a.(regexp.Regexp), b.(regexp.Regexp)
+	return ra.String() == rb.String()
+}
+
+func sameURL(a, b interface{}) bool {
+	ua, ub := a.(url.URL), b.(url.URL)
+	return ua.String() == ub.String()
+}
+
+// samePercent allows the rounding error of fractions such as 1/3, which no percentage
+// reads back as exactly
+func samePercent(a, b interface{}) bool {
+	if pa, ok := a.(*converter.Percent); ok {
+		pb := b.(*converter.Percent)
+		if pa == nil || pb == nil {
+			return pa == pb
+		}
+		a, b = *pa, *pb
+	}
+	fa, fb := float64(a.(converter.Percent)), float64(b.(converter.Percent))
+	return fa == fb || math.Abs(fa-fb) <= 1e-15*math.Abs(fa)
+}
+
+func FuzzInt(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToInt, target: int(0)},
+		{convert: converter.StringToInt8, target: int8(0), result: int64(0)},
+		{convert: converter.StringToInt16, target: int16(0), result: int64(0)},
+		{convert: converter.StringToInt32, target: int32(0), result: int64(0)},
+		{convert: converter.StringToInt64, target: int64(0)},
+		{convert: converter.StringToIntPtr, target: new(int)},
+		{convert: converter.StringToInt8Ptr, target: new(int8), result: new(int64)},
+		{convert: converter.StringToInt16Ptr, target: new(int16), result: new(int64)},
+		{convert: converter.StringToInt32Ptr, target: new(int32), result: new(int64)},
+		{convert: converter.StringToInt64Ptr, target: new(int64)},
+	})
+}
+
+func FuzzUint(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToUint, target: uint(0), result: uint64(0)},
+		{convert: converter.StringToUint8, target: uint8(0), result: uint64(0)},
+		{convert: converter.StringToUint16, target: uint16(0), result: uint64(0)},
+		{convert: converter.StringToUint32, target: uint32(0), result: uint64(0)},
+		{convert: converter.StringToUint64, target: uint64(0)},
+		{convert: converter.StringToUintPtr, target: new(uint), result: new(uint64)},
+		{convert: converter.StringToUint8Ptr, target: new(uint8), result: new(uint64)},
+		{convert: converter.StringToUint16Ptr, target: new(uint16), result: new(uint64)},
+		{convert: converter.StringToUint32Ptr, target: new(uint32), result: new(uint64)},
+		{convert: converter.StringToUint64Ptr, target: new(uint64)},
+	})
+}
+
+func FuzzFloat(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToFloat32, target: float32(0), result: float64(0)},
+		{convert: converter.StringToFloat64, target: float64(0)},
+		{convert: converter.StringToFloat32Ptr, target: new(float32), result: new(float64)},
+		{convert: converter.StringToFloat64Ptr, target: new(float64)},
+	})
+}
+
+func FuzzComplex(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToComplex64, target: complex64(0)},
+		{convert: converter.StringToComplex128, target: complex128(0)},
+		{convert: converter.StringToComplex64Ptr, target: new(complex64)},
+		{convert: converter.StringToComplex128Ptr, target: new(complex128)},
+	})
+}
+
+func FuzzNumber(f *testing.F) {
+	options := converter.NumberOptions{
+		SI:              true,
+		Binary:          true,
+		Percent:         true,
+		Overflow:        converter.OverflowSaturate,
+		LenientIntegers: true,
+		IntegerRounding: converter.IntegerHalfEven,
+	}
+	var cases []fuzzCase
+	for _, target := range []interface{}{int8(0), int64(0), uint16(0), float32(0), float64(0), big.Int{}, new(big.Rat), decimal.Decimal{}} {
+		for _, opts := range []converter.NumberOptions{{}, options} {
+			convert, err := converter.NewNumberConverter(reflect.TypeOf(target), opts)
+			if err != nil {
+				f.Fatalf("NewNumberConverter(%T) unexpected error: %v", target, err)
+			}
+			cases = append(cases, fuzzCase{convert: convert, target: target})
+		}
+	}
+	fuzzConverters(f, cases)
+}
+
+func FuzzPercent(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToPercent, target: converter.Percent(0), equal: samePercent},
+		{convert: converter.StringToPercentPtr, target: new(converter.Percent), equal: samePercent},
+	})
+}
+
+func FuzzBig(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToBigInt, target: big.Int{}},
+		{convert: converter.StringToBigIntPtr, target: new(big.Int)},
+		{convert: converter.StringToBigFloat, target: big.Float{}},
+		{convert: converter.StringToBigFloatPtr, target: new(big.Float)},
+		{convert: converter.StringToBigRat, target: big.Rat{}},
+		{convert: converter.StringToBigRatPtr, target: new(big.Rat)},
+	})
+}
+
+func FuzzDecimal(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToDecimal, target: decimal.Decimal{}},
+		{convert: converter.StringToDecimalPtr, target: new(decimal.Decimal)},
+	})
+}
+
+func FuzzBool(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToBool, target: false},
+		{convert: converter.StringToBoolPtr, target: new(bool)},
+	})
+}
+
+func FuzzString(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToString, target: ""},
+		{convert: converter.StringToStringPtr, target: new(string)},
+	})
+}
+
+func FuzzBytes(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToBytes, target: []byte{}},
+	})
+}
+
+func FuzzTime(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToTime, target: time.Time{}},
+		{convert: converter.StringToTimePtr, target: new(time.Time)},
+		{convert: converter.StringToDuration, target: time.Duration(0)},
+		{convert: converter.StringToDurationPtr, target: new(time.Duration)},
+	})
+}
+
+func FuzzSQL(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToNullString, target: sql.NullString{}},
+		{convert: converter.StringToNullInt64, target: sql.NullInt64{}},
+		{convert: converter.StringToNullBool, target: sql.NullBool{}},
+		{convert: converter.StringToNullFloat64, target: sql.NullFloat64{}},
+		{convert: converter.StringToNullTime, target: sql.NullTime{}},
+	})
+}
+
+func FuzzNet(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToNetipAddr, target: netip.Addr{}},
+		{convert: converter.StringToNetipPrefix, target: netip.Prefix{}},
+		{convert: converter.StringToNetipAddrPort, target: netip.AddrPort{}},
+		{convert: converter.StringToIP, target: net.IP{}},
+		{convert: converter.StringToIPNet, target: &net.IPNet{}, equal: sameText},
+		{convert: converter.StringToHardwareAddr, target: net.HardwareAddr{}},
+		{convert: converter.StringToTCPAddr, target: &net.TCPAddr{}, equal: sameText},
+		{convert: converter.StringToUDPAddr, target: &net.UDPAddr{}, equal: sameText},
+	})
+}
+
+func FuzzURL(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToURL, target: url.URL{}, equal: sameURL},
+		{convert: converter.StringToURLPtr, target: &url.URL{}, equal: sameText},
+	})
+}
+
+func FuzzMail(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToMailAddress, target: mail.Address{}},
+		{convert: converter.StringToMailAddressPtr, target: &mail.Address{}},
+	})
+}
+
+func FuzzMediaType(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToMediaType, target: converter.MediaType{}},
+		{convert: converter.StringToMediaTypePtr, target: &converter.MediaType{}},
+	})
+}
+
+func FuzzRegexp(f *testing.F) {
+	fuzzConverters(f, []fuzzCase{
+		{convert: converter.StringToRegexp, target: regexp.Regexp{}, equal: sameRegexp},
+		{convert: converter.StringToRegexpPtr, target: &regexp.Regexp{}, equal: sameText},
+	})
+}
+
+// TestFuzzTargetsCoverConverterMap tests that every registered converter has a fuzz target
+func TestFuzzTargetsCoverConverterMap(t *testing.T) {
+	covered := map[reflect.Type]bool{}
+	for _, target := range []interface{}{
+		int(0), int8(0), int16(0), int32(0), int64(0), new(int), new(int8), new(int16), new(int32), new(int64),
+		uint(0), uint8(0), uint16(0), uint32(0), uint64(0), new(uint), new(uint8), new(uint16), new(uint32), new(uint64),
+		float32(0), float64(0), new(float32), new(float64),
+		complex64(0), complex128(0), new(complex64), new(complex128),
+		converter.Percent(0), new(converter.Percent),
+		big.Int{}, new(big.Int), big.Float{}, new(big.Float), big.Rat{}, new(big.Rat),
+		decimal.Decimal{}, new(decimal.Decimal), false, new(bool), "", new(string), []byte{},
+		time.Time{}, new(time.Time), time.Duration(0), new(time.Duration),
+		sql.NullString{}, sql.NullInt64{}, sql.NullBool{}, sql.NullFloat64{}, sql.NullTime{},
+		netip.Addr{}, netip.Prefix{}, netip.AddrPort{}, net.IP{}, &net.IPNet{}, net.HardwareAddr{}, &net.TCPAddr{}, &net.UDPAddr{},
+		url.URL{}, &url.URL{}, mail.Address{}, &mail.Address{},
+		converter.MediaType{}, &converter.MediaType{}, regexp.Regexp{}, &regexp.Regexp{},
+	} {
+		covered[reflect.TypeOf(target)] = true
+	}
+
+	for targetType := range converter.GetConvertorMap() {
+		if !covered[targetType] {
+			t.Errorf("no fuzz target covers the converter for %s", targetType)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"net/mail"
+	"reflect"
+)
+
+func init() {
+	registerConverter(reflect.TypeOf(mail.Address{}), StringToMailAddress)
+	registerConverter(reflect.TypeOf(&mail.Address{}), StringToMailAddressPtr)
+}
+
+// StringToMailAddress parses a single RFC 5322 address such as "Jane Doe <jane@example.com>"
+func StringToMailAddress(value string) (interface{}, error) {
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return nil, err
+	}
+	return *address, nil
+}
+
+func StringToMailAddressPtr(value string) (interface{}, error) {
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return nil, err
+	}
+	return address, nil
+}
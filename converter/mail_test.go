@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"net/mail"
+	"testing"
+)
+
+func TestStringToMailAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected mail.Address
+		hasError bool
+	}{
+		{"bare address", "jane@example.com", mail.Address{Address: "jane@example.com"}, false},
+		{"named address", "Jane Doe <jane@example.com>", mail.Address{Name: "Jane Doe", Address: "jane@example.com"}, false},
+		{"quoted name", `"Doe, Jane" <jane@example.com>`, mail.Address{Name: "Doe, Jane", Address: "jane@example.com"}, false},
+		{"missing domain", "jane@", mail.Address{}, true},
+		{"missing at sign", "jane.example.com", mail.Address{}, true},
+		{"address list", "a@example.com, b@example.com", mail.Address{}, true},
+		{"empty string", "", mail.Address{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToMailAddress(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToMailAddress(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToMailAddress(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToMailAddress(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("StringToMailAddress(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToMailAddressPtr(t *testing.T) {
+	result, err := StringToMailAddressPtr("Ops <ops@example.com>")
+	if err != nil {
+		t.Fatalf("StringToMailAddressPtr unexpected error: %v", err)
+	}
+	if address := result.(*mail.Address); address.Name != "Ops" || address.Address != "ops@example.com" {
+		t.Errorf("StringToMailAddressPtr = %v, expected Ops <ops@example.com>", address)
+	}
+
+	if result, err := StringToMailAddressPtr("not an address"); err == nil || result != nil {
+		t.Errorf("StringToMailAddressPtr expected nil result and error, got %v, %v", result, err)
+	}
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"fmt"
+	"mime"
+	"reflect"
+	"strings"
+)
+
+// MediaType is a parsed MIME media type such as "text/html; charset=utf-8"
+type MediaType struct {
+	Type    string
+	Subtype string
+	Params  map[string]string
+}
+
+func init() {
+	registerConverter(reflect.TypeOf(MediaType{}), StringToMediaType)
+	registerConverter(reflect.TypeOf(&MediaType{}), StringToMediaTypePtr)
+}
+
+// String formats the media type back to its canonical form
+func (m MediaType) String() string {
+	return mime.FormatMediaType(m.Type+"/"+m.Subtype, m.Params)
+}
+
+func StringToMediaType(value string) (interface{}, error) {
+	mediaType, err := parseMediaType(value)
+	if err != nil {
+		return nil, err
+	}
+	return mediaType, nil
+}
+
+func StringToMediaTypePtr(value string) (interface{}, error) {
+	mediaType, err := parseMediaType(value)
+	if err != nil {
+		return nil, err
+	}
+	return &mediaType, nil
+}
+
+func parseMediaType(value string) (MediaType, error) {
+	fullType, params, err := mime.ParseMediaType(value)
+	if err != nil {
+		return MediaType{}, fmt.Errorf("invalid media type %q: %w", value, err)
+	}
+
+	mainType, subtype, found := strings.Cut(fullType, "/")
+	if !found || mainType == "" || subtype == "" {
+		return MediaType{}, fmt.Errorf("invalid media type %q: expected type/subtype", value)
+	}
+	return MediaType{Type: mainType, Subtype: subtype, Params: params}, nil
+}
@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringToMediaType(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected MediaType
+		hasError bool
+	}{
+		{"simple", "application/json", MediaType{Type: "application", Subtype: "json", Params: map[string]string{}}, false},
+		{"with params", "text/html; charset=utf-8", MediaType{Type: "text", Subtype: "html", Params: map[string]string{"charset": "utf-8"}}, false},
+		{"mixed case", "Text/HTML; Charset=UTF-8", MediaType{Type: "text", Subtype: "html", Params: map[string]string{"charset": "UTF-8"}}, false},
+		{"quoted param", `multipart/form-data; boundary="a b"`, MediaType{Type: "multipart", Subtype: "form-data", Params: map[string]string{"boundary": "a b"}}, false},
+		{"missing subtype", "text", MediaType{}, true},
+		{"empty subtype", "text/", MediaType{}, true},
+		{"bad param", "text/plain; charset", MediaType{}, true},
+		{"empty string", "", MediaType{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToMediaType(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToMediaType(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToMediaType(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToMediaType(%q) unexpected error: %v", tt.input, err)
+				}
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("StringToMediaType(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToMediaTypePtr(t *testing.T) {
+	result, err := StringToMediaTypePtr("image/png")
+	if err != nil {
+		t.Fatalf("StringToMediaTypePtr unexpected error: %v", err)
+	}
+	if mediaType := result.(*MediaType); mediaType.Type != "image" || mediaType.Subtype != "png" {
+		t.Errorf("StringToMediaTypePtr = %v, expected image/png", mediaType)
+	}
+
+	if result, err := StringToMediaTypePtr("/png"); err == nil || result != nil {
+		t.Errorf("StringToMediaTypePtr expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestMediaTypeString(t *testing.T) {
+	mediaType := MediaType{Type: "text", Subtype: "plain", Params: map[string]string{"charset": "utf-8"}}
+	if got := mediaType.String(); got != "text/plain; charset=utf-8" {
+		t.Errorf("MediaType.String() = %q, expected %q", got, "text/plain; charset=utf-8")
+	}
+}
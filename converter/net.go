@@ -0,0 +1,185 @@
+package converter
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	registerConverter(reflect.TypeOf(netip.Addr{}), StringToNetipAddr)
+	registerConverter(reflect.TypeOf(netip.Prefix{}), StringToNetipPrefix)
+	registerConverter(reflect.TypeOf(netip.AddrPort{}), StringToNetipAddrPort)
+	registerConverter(reflect.TypeOf(net.IP{}), StringToIP)
+	registerConverter(reflect.TypeOf(&net.IPNet{}), StringToIPNet)
+	registerConverter(reflect.TypeOf(net.HardwareAddr{}), StringToHardwareAddr)
+	registerConverter(reflect.TypeOf(&net.TCPAddr{}), StringToTCPAddr)
+	registerConverter(reflect.TypeOf(&net.UDPAddr{}), StringToUDPAddr)
+}
+
+func StringToNetipAddr(value string) (interface{}, error) {
+	addr, err := parseAddr(value)
+	if err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+func StringToNetipPrefix(value string) (interface{}, error) {
+	prefix, err := parsePrefix(value)
+	if err != nil {
+		return nil, err
+	}
+	return prefix, nil
+}
+
+func StringToNetipAddrPort(value string) (interface{}, error) {
+	addr, port, err := parseHostPort(value, false)
+	if err != nil {
+		return nil, err
+	}
+	return netip.AddrPortFrom(addr, port), nil
+}
+
+func StringToIP(value string) (interface{}, error) {
+	addr, err := parseAddr(value)
+	if err != nil {
+		return nil, err
+	}
+	if addr.Zone() != "" {
+		return nil, fmt.Errorf("invalid IPv6 address %q: net.IP cannot hold a zone", value)
+	}
+	return net.IP(addr.AsSlice()), nil
+}
+
+// StringToIPNet converts CIDR notation to the network it describes, e.g. "10.1.2.3/8" to 10.0.0.0/8
+func StringToIPNet(value string) (interface{}, error) {
+	prefix, err := parsePrefix(value)
+	if err != nil {
+		return nil, err
+	}
+	network := prefix.Masked()
+	return &net.IPNet{
+		IP:   net.IP(network.Addr().AsSlice()),
+		Mask: net.CIDRMask(network.Bits(), network.Addr().BitLen()),
+	}, nil
+}
+
+func StringToHardwareAddr(value string) (interface{}, error) {
+	hardwareAddr, err := net.ParseMAC(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hardware address %q: %w", value, err)
+	}
+	return hardwareAddr, nil
+}
+
+// StringToTCPAddr converts "ip:port" to a *net.TCPAddr. Host names are rejected rather than resolved.
+func StringToTCPAddr(value string) (interface{}, error) {
+	addr, port, err := parseHostPort(value, true)
+	if err != nil {
+		return nil, err
+	}
+	return &net.TCPAddr{IP: addrIP(addr), Port: int(port), Zone: addr.Zone()}, nil
+}
+
+// StringToUDPAddr converts "ip:port" to a *net.UDPAddr. Host names are rejected rather than resolved.
+func StringToUDPAddr(value string) (interface{}, error) {
+	addr, port, err := parseHostPort(value, true)
+	if err != nil {
+		return nil, err
+	}
+	return &net.UDPAddr{IP: addrIP(addr), Port: int(port), Zone: addr.Zone()}, nil
+}
+
+func parseAddr(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid %s address: %w", ipFamily(value), err)
+	}
+	return addr, nil
+}
+
+func parsePrefix(value string) (netip.Prefix, error) {
+	address, length, found := strings.Cut(value, "/")
+	if !found {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR prefix %q: missing /length", value)
+	}
+
+	addr, err := parseAddr(address)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if addr.Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("invalid IPv6 prefix %q: prefixes cannot have a zone", value)
+	}
+
+	family := "IPv4"
+	if addr.Is6() {
+		family = "IPv6"
+	}
+	bits, err := strconv.Atoi(length)
+	if err != nil || bits < 0 {
+		return netip.Prefix{}, fmt.Errorf("invalid %s prefix %q: bad prefix length %q", family, value, length)
+	}
+	if bits > addr.BitLen() {
+		return netip.Prefix{}, fmt.Errorf("invalid %s prefix %q: prefix length %d exceeds %d", family, value, bits, addr.BitLen())
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid %s prefix: %w", family, err)
+	}
+	return prefix, nil
+}
+
+// parseHostPort splits "host:port" without resolving names. An empty host is only
+// accepted when allowEmptyHost is set, matching listen addresses such as ":8080".
+func parseHostPort(value string, allowEmptyHost bool) (netip.Addr, uint16, error) {
+	host, portText, err := net.SplitHostPort(value)
+	if err != nil {
+		return netip.Addr{}, 0, fmt.Errorf("invalid host:port %q: %w", value, err)
+	}
+
+	port, err := strconv.ParseUint(portText, 10, 16)
+	if err != nil {
+		return netip.Addr{}, 0, fmt.Errorf("invalid port %q in %q: must be a number between 0 and 65535", portText, value)
+	}
+
+	if host == "" {
+		if !allowEmptyHost {
+			return netip.Addr{}, 0, fmt.Errorf("invalid host:port %q: missing IP address", value)
+		}
+		return netip.Addr{}, uint16(port), nil
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		if ipFamily(host) == "IP" {
+			return netip.Addr{}, 0, fmt.Errorf("invalid host %q in %q: not an IP address (host names are not resolved)", host, value)
+		}
+		return netip.Addr{}, 0, fmt.Errorf("invalid %s address in %q: %w", ipFamily(host), value, err)
+	}
+	return addr, uint16(port), nil
+}
+
+// addrIP converts addr to a net.IP, mapping an empty host to nil
+func addrIP(addr netip.Addr) net.IP {
+	if !addr.IsValid() {
+		return nil
+	}
+	return net.IP(addr.AsSlice())
+}
+
+// ipFamily guesses which address family value was meant to be, for error messages
+func ipFamily(value string) string {
+	switch {
+	case strings.Contains(value, ":"):
+		return "IPv6"
+	case strings.Trim(value, "0123456789.") == "" && strings.Contains(value, "."):
+		return "IPv4"
+	}
+	return "IP"
+}
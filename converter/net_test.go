@@ -0,0 +1,215 @@
+package converter
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestStringToNetipAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		errorHas string
+	}{
+		{"IPv4", "192.168.1.10", "192.168.1.10", ""},
+		{"IPv6", "2001:db8::1", "2001:db8::1", ""},
+		{"IPv6 with zone", "fe80::1%eth0", "fe80::1%eth0", ""},
+		{"IPv4 octet out of range", "256.1.1.1", "", "IPv4"},
+		{"IPv4 too few octets", "10.0.1", "", "IPv4"},
+		{"IPv6 bad group", "2001:db8::zz", "", "IPv6"},
+		{"host name", "localhost", "", "invalid IP address"},
+		{"empty string", "", "", "invalid IP address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToNetipAddr(tt.input)
+
+			if tt.errorHas != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorHas) {
+					t.Errorf("StringToNetipAddr(%q) error = %v, expected it to mention %q", tt.input, err, tt.errorHas)
+				}
+				if result != nil {
+					t.Errorf("StringToNetipAddr(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToNetipAddr(%q) unexpected error: %v", tt.input, err)
+				}
+				if result.(netip.Addr).String() != tt.expected {
+					t.Errorf("StringToNetipAddr(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToNetipPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		errorHas string
+	}{
+		{"IPv4", "10.0.0.0/8", "10.0.0.0/8", ""},
+		{"IPv4 host bits kept", "10.1.2.3/8", "10.1.2.3/8", ""},
+		{"IPv6", "2001:db8::/32", "2001:db8::/32", ""},
+		{"IPv4 length too long", "10.0.0.0/33", "", "IPv4 prefix"},
+		{"IPv6 length too long", "2001:db8::/129", "", "IPv6 prefix"},
+		{"IPv6 length fits only IPv6", "2001:db8::/64", "2001:db8::/64", ""},
+		{"missing length", "10.0.0.0", "", "missing /length"},
+		{"bad length", "10.0.0.0/x", "", "bad prefix length"},
+		{"bad IPv4 address", "10.0.0.256/8", "", "IPv4 address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToNetipPrefix(tt.input)
+
+			if tt.errorHas != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorHas) {
+					t.Errorf("StringToNetipPrefix(%q) error = %v, expected it to mention %q", tt.input, err, tt.errorHas)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToNetipPrefix(%q) unexpected error: %v", tt.input, err)
+				}
+				if result.(netip.Prefix).String() != tt.expected {
+					t.Errorf("StringToNetipPrefix(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToNetipAddrPort(t *testing.T) {
+	result, err := StringToNetipAddrPort("[::1]:8080")
+	if err != nil {
+		t.Fatalf("StringToNetipAddrPort unexpected error: %v", err)
+	}
+	if result.(netip.AddrPort) != netip.MustParseAddrPort("[::1]:8080") {
+		t.Errorf("StringToNetipAddrPort = %v, expected [::1]:8080", result)
+	}
+
+	for _, input := range []string{":8080", "127.0.0.1", "127.0.0.1:70000", "example.com:80"} {
+		if _, err := StringToNetipAddrPort(input); err == nil {
+			t.Errorf("StringToNetipAddrPort(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestStringToIP(t *testing.T) {
+	result, err := StringToIP("192.0.2.1")
+	if err != nil {
+		t.Fatalf("StringToIP unexpected error: %v", err)
+	}
+	if !result.(net.IP).Equal(net.ParseIP("192.0.2.1")) {
+		t.Errorf("StringToIP = %v, expected 192.0.2.1", result)
+	}
+
+	result, err = StringToIP("2001:db8::1")
+	if err != nil || !result.(net.IP).Equal(net.ParseIP("2001:db8::1")) {
+		t.Errorf("StringToIP(IPv6) = %v, %v; expected 2001:db8::1", result, err)
+	}
+
+	for _, input := range []string{"fe80::1%eth0", "1.2.3", "example.com"} {
+		if result, err := StringToIP(input); err == nil || result != nil {
+			t.Errorf("StringToIP(%q) = %v, %v; expected nil result and error", input, result, err)
+		}
+	}
+}
+
+func TestStringToIPNet(t *testing.T) {
+	result, err := StringToIPNet("10.1.2.3/8")
+	if err != nil {
+		t.Fatalf("StringToIPNet unexpected error: %v", err)
+	}
+	ipNet := result.(*net.IPNet)
+	if ipNet.String() != "10.0.0.0/8" {
+		t.Errorf("StringToIPNet = %v, expected 10.0.0.0/8", ipNet)
+	}
+
+	result, err = StringToIPNet("2001:db8::1/48")
+	if err != nil || result.(*net.IPNet).String() != "2001:db8::/48" {
+		t.Errorf("StringToIPNet(IPv6) = %v, %v; expected 2001:db8::/48", result, err)
+	}
+
+	if _, err := StringToIPNet("10.0.0.0/40"); err == nil || !strings.Contains(err.Error(), "IPv4") {
+		t.Errorf("StringToIPNet expected IPv4 error, got %v", err)
+	}
+}
+
+func TestStringToHardwareAddr(t *testing.T) {
+	result, err := StringToHardwareAddr("00:1a:2b:3c:4d:5e")
+	if err != nil {
+		t.Fatalf("StringToHardwareAddr unexpected error: %v", err)
+	}
+	if result.(net.HardwareAddr).String() != "00:1a:2b:3c:4d:5e" {
+		t.Errorf("StringToHardwareAddr = %v, expected 00:1a:2b:3c:4d:5e", result)
+	}
+
+	if _, err := StringToHardwareAddr("00:1a:2b"); err == nil {
+		t.Error("StringToHardwareAddr expected error for short address")
+	}
+}
+
+func TestStringToTCPAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"IPv4", "127.0.0.1:8080", "127.0.0.1:8080", false},
+		{"IPv6", "[2001:db8::1]:443", "[2001:db8::1]:443", false},
+		{"IPv6 with zone", "[fe80::1%eth0]:22", "[fe80::1%eth0]:22", false},
+		{"listen address", ":9090", ":9090", false},
+		{"host name", "localhost:80", "", true},
+		{"named port", "127.0.0.1:http", "", true},
+		{"port out of range", "127.0.0.1:65536", "", true},
+		{"missing port", "127.0.0.1", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToTCPAddr(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToTCPAddr(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToTCPAddr(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToTCPAddr(%q) unexpected error: %v", tt.input, err)
+				}
+				if result.(*net.TCPAddr).String() != tt.expected {
+					t.Errorf("StringToTCPAddr(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	if _, err := StringToTCPAddr("db.internal:5432"); err == nil || !strings.Contains(err.Error(), "not resolved") {
+		t.Errorf("StringToTCPAddr should explain that host names are not resolved, got %v", err)
+	}
+}
+
+func TestStringToUDPAddr(t *testing.T) {
+	result, err := StringToUDPAddr("0.0.0.0:53")
+	if err != nil {
+		t.Fatalf("StringToUDPAddr unexpected error: %v", err)
+	}
+	if udpAddr := result.(*net.UDPAddr); udpAddr.Port != 53 || !udpAddr.IP.Equal(net.IPv4zero) {
+		t.Errorf("StringToUDPAddr = %v, expected 0.0.0.0:53", udpAddr)
+	}
+
+	if _, err := StringToUDPAddr("[::1]:-1"); err == nil {
+		t.Error("StringToUDPAddr expected error for negative port")
+	}
+}
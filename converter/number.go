@@ -0,0 +1,816 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dheeraj-sn/str2go/decimal"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// NumberFormat describes how numbers are written in the input
+type NumberFormat struct {
+	// DecimalSeparator separates the integer and fractional parts; zero means '.'
+	DecimalSeparator rune
+	// GroupSeparator separates groups of three integer digits, e.g. ',' in "1,234.56";
+	// zero means grouping is not accepted
+	GroupSeparator rune
+	// CurrencySymbols are stripped when they appear before or after the number, e.g. "$" or "EUR"
+	CurrencySymbols []string
+	// AccountingNegative reads a number in parentheses, such as "(123)", as negative
+	AccountingNegative bool
+}
+
+// NumberOptions configures the converters returned by NumberConverters and NewNumberConverter
+type NumberOptions struct {
+	Format NumberFormat
+	// SI accepts an SI prefix after int, uint and float values, from "f" (1e-15) to "E" (1e18):
+	// "1.5k" is 1500 and "250m" is 0.25. "K" is accepted for kilo and "u" or "µ" for micro.
+	SI bool
+	// Binary accepts the binary multipliers "Ki", "Mi", "Gi", "Ti", "Pi" and "Ei" (powers of 1024)
+	Binary bool
+	// Percent lets float values be written as percentages ("75%") or ratios ("3/4"),
+	// both read as fractions (0.75)
+	Percent bool
+	// Overflow selects what integer converters do with values outside the target range
+	Overflow OverflowPolicy
+	// Float restricts the values accepted by float converters
+	Float FloatPolicy
+	// LenientIntegers lets integer converters accept float notation such as "42.0" or "1e3"
+	LenientIntegers bool
+	// IntegerRounding decides what happens to fractional values from float notation or
+	// suffixes; the default, IntegerStrict, rejects them
+	IntegerRounding IntegerRounding
+	// BigFloat sets the precision and rounding of big.Float results
+	BigFloat BigFloatOptions
+	// Observe, when set, is called after every successful conversion
+	Observe func(NumberEvent)
+}
+
+// FloatPolicy restricts special and inexact values in float converters. Values too large for
+// the target type are always rejected.
+type FloatPolicy struct {
+	// RejectNaN rejects "NaN"
+	RejectNaN bool
+	// RejectInf rejects "Inf", "+Inf" and "-Inf"
+	RejectInf bool
+	// RejectPrecisionLoss rejects float32 values whose nearest float32 is a different number
+	// than the exact input, such as "16777217" or "0.1"; the loss is always reported in
+	// NumberEvent
+	RejectPrecisionLoss bool
+	// RejectSubnormal rejects subnormal results and non-zero values that underflow to zero
+	RejectSubnormal bool
+}
+
+// IntegerRounding selects how integer converters handle values with a fractional part
+type IntegerRounding int
+
+const (
+	// IntegerStrict rejects values with a fractional part
+	IntegerStrict IntegerRounding = iota
+	// IntegerTruncate discards the fractional part, rounding toward zero
+	IntegerTruncate
+	// IntegerHalfEven rounds to the nearest integer, and ties to the even neighbour
+	IntegerHalfEven
+	// IntegerFloor rounds toward negative infinity
+	IntegerFloor
+	// IntegerCeil rounds toward positive infinity
+	IntegerCeil
+)
+
+// OverflowPolicy selects how out-of-range values are stored in sized integer types
+type OverflowPolicy int
+
+const (
+	// OverflowError rejects out-of-range values
+	OverflowError OverflowPolicy = iota
+	// OverflowSaturate clamps out-of-range values to the minimum or maximum of the type
+	OverflowSaturate
+	// OverflowWrap keeps the low bits of the value, as a Go conversion between integer types does
+	OverflowWrap
+)
+
+// NumberEvent describes how a number converter interpreted its input
+type NumberEvent struct {
+	Input string
+	Type  reflect.Type
+	// Suffix is the SI or binary suffix that was applied, or empty if there was none
+	Suffix string
+	// Multiplier is the factor the suffix stands for, e.g. 1000 for "k"
+	Multiplier float64
+	// Saturated reports that an out-of-range value was clamped under OverflowSaturate
+	Saturated bool
+	// Wrapped reports that an out-of-range value was wrapped under OverflowWrap
+	Wrapped bool
+	// PrecisionLoss reports that a float32 value differs from its input
+	PrecisionLoss bool
+	// Underflow reports that a non-zero float value was too small for its type and became zero
+	Underflow bool
+	// Rounded reports that the fractional part of an integer value was rounded away
+	Rounded bool
+}
+
+// siSuffixes maps SI prefixes to powers of ten
+var siSuffixes = map[string]int{
+	"f": -15, "p": -12, "n": -9, "u": -6, "µ": -6, "μ": -6, "m": -3,
+	"k": 3, "K": 3, "M": 6, "G": 9, "T": 12, "P": 15, "E": 18,
+}
+
+// binarySuffixes maps binary multipliers to powers of 1024
+var binarySuffixes = map[string]int{
+	"Ki": 1, "Mi": 2, "Gi": 3, "Ti": 4, "Pi": 5, "Ei": 6,
+}
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+	decimalType  = reflect.TypeOf(decimal.Decimal{})
+)
+
+// NumberConverters returns converters for every int, uint, float, big-number and decimal type,
+// and their pointers, that apply opts. Unlike the default converters, which return int64,
+// uint64 or float64 for sized types, these return values of exactly the target type.
+// With an invalid Format every converter returns the format error.
+func NumberConverters(opts NumberOptions) map[reflect.Type]model.ConverterFunc {
+	types := []reflect.Type{
+		reflect.TypeOf(int(0)), reflect.TypeOf(int8(0)), reflect.TypeOf(int16(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+		reflect.TypeOf(uint(0)), reflect.TypeOf(uint8(0)), reflect.TypeOf(uint16(0)), reflect.TypeOf(uint32(0)), reflect.TypeOf(uint64(0)),
+		reflect.TypeOf(float32(0)), reflect.TypeOf(float64(0)),
+		bigIntType, bigFloatType, bigRatType, decimalType,
+	}
+
+	converters := make(map[reflect.Type]model.ConverterFunc, 2*len(types))
+	for _, t := range types {
+		for _, target := range []reflect.Type{t, reflect.PointerTo(t)} {
+			converter, err := NewNumberConverter(target, opts)
+			if err != nil {
+				converter = failingConverter(target, err)
+			}
+			converters[target] = converter
+		}
+	}
+	return converters
+}
+
+// NewNumberConverter returns a converter applying opts for targetType, which may be any
+// int, uint or float kind (including named types), a big-number type, decimal.Decimal,
+// or a pointer to one of these. It returns an error if opts.Format uses the same separator
+// for decimals and groups.
+func NewNumberConverter(targetType reflect.Type, opts NumberOptions) (model.ConverterFunc, error) {
+	if err := opts.Format.validate(); err != nil {
+		return nil, err
+	}
+	base := targetType
+	if base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+
+	p := &numberParser{opts: opts}
+	var set func(v reflect.Value, value string, event *NumberEvent) error
+	switch base {
+	case bigIntType:
+		set = p.setBigInt
+	case bigFloatType:
+		set = p.setBigFloat
+	case bigRatType:
+		set = p.setBigRat
+	case decimalType:
+		set = p.setDecimal
+	default:
+		switch base.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			set = p.setInteger
+		case reflect.Float32, reflect.Float64:
+			set = p.setFloat
+		default:
+			return nil, fmt.Errorf("%s is not a numeric type", targetType)
+		}
+	}
+
+	return func(value string) (interface{}, error) {
+		v := reflect.New(base)
+		event := NumberEvent{Input: value, Type: targetType}
+		if err := set(v.Elem(), value, &event); err != nil {
+			return nil, &model.ConversionError{Value: value, Type: targetType, Offset: -1, Err: err}
+		}
+		if opts.Observe != nil {
+			opts.Observe(event)
+		}
+		if targetType.Kind() == reflect.Pointer {
+			return v.Interface(), nil
+		}
+		return v.Elem().Interface(), nil
+	}, nil
+}
+
+// failingConverter returns a converter that reports err for every value
+func failingConverter(targetType reflect.Type, err error) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		return nil, &model.ConversionError{Value: value, Type: targetType, Offset: -1, Err: err}
+	}
+}
+
+// numberParser implements the shared parsing pipeline behind the number converters
+type numberParser struct {
+	opts NumberOptions
+}
+
+func (p *numberParser) setInteger(v reflect.Value, value string, event *NumberEvent) error {
+	text, multiplier, err := p.normalizeScaled(value, event)
+	if err != nil {
+		return err
+	}
+
+	var n *big.Int
+	if multiplier == nil && !p.opts.LenientIntegers {
+		var ok bool
+		if n, ok = new(big.Int).SetString(text, 10); !ok {
+			return fmt.Errorf("invalid integer: %q", value)
+		}
+	} else {
+		r, ok := parseRat(text)
+		if !ok {
+			if multiplier != nil {
+				return fmt.Errorf("invalid number before suffix %q: %q", event.Suffix, value)
+			}
+			return fmt.Errorf("invalid integer: %q", value)
+		}
+		if multiplier != nil {
+			r.Mul(r, multiplier)
+		}
+		if n, err = p.roundInteger(r, event); err != nil {
+			if multiplier != nil {
+				return fmt.Errorf("%s with suffix %q %w", text, event.Suffix, err)
+			}
+			return fmt.Errorf("%s %w", text, err)
+		}
+	}
+
+	if err := p.setBigInteger(v, n, event); err != nil {
+		if multiplier != nil {
+			return fmt.Errorf("%w after applying suffix %q", err, event.Suffix)
+		}
+		return err
+	}
+	return nil
+}
+
+// roundInteger converts r to an integer using the configured IntegerRounding
+func (p *numberParser) roundInteger(r *big.Rat, event *NumberEvent) (*big.Int, error) {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num()), nil
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	negative := r.Sign() < 0
+	up := false // round away from zero
+	switch p.opts.IntegerRounding {
+	case IntegerTruncate:
+	case IntegerHalfEven:
+		// Compare twice the remainder with the denominator to detect halves
+		twice := new(big.Int).Abs(remainder)
+		twice.Lsh(twice, 1)
+		cmp := twice.Cmp(r.Denom())
+		up = cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1)
+	case IntegerFloor:
+		up = negative
+	case IntegerCeil:
+		up = !negative
+	default:
+		return nil, errors.New("is not an integer: its fractional part would be discarded")
+	}
+
+	if up {
+		if negative {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	event.Rounded = true
+	return quotient, nil
+}
+
+func (p *numberParser) setFloat(v reflect.Value, value string, event *NumberEvent) error {
+	bits := v.Type().Bits()
+	f, err := p.parseFloatValue(value, bits, event)
+	if err != nil {
+		return err
+	}
+	if err := p.checkFloat(f, bits, value, event); err != nil {
+		return err
+	}
+	v.SetFloat(f)
+	return nil
+}
+
+// parseFloatValue parses a float, including percentages and ratios when enabled
+func (p *numberParser) parseFloatValue(value string, bits int, event *NumberEvent) (float64, error) {
+	if !p.opts.Percent {
+		return p.parseFloat(value, bits, event)
+	}
+
+	f, err := parseFraction(value, 1, func(text string) (float64, error) {
+		return p.parseFloat(text, bits, event)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if bits == 32 && math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+		return 0, fmt.Errorf("value %g out of range for float32", f)
+	}
+	return f, nil
+}
+
+// parseFloat parses one number, applying any suffix, at the given precision
+func (p *numberParser) parseFloat(value string, bits int, event *NumberEvent) (float64, error) {
+	text, multiplier, err := p.normalizeScaled(value, event)
+	if err != nil {
+		return 0, err
+	}
+
+	if multiplier == nil {
+		f, err := strconv.ParseFloat(text, bits)
+		if err == nil && f == 0 && hasNonZeroDigit(text) {
+			event.Underflow = true
+		}
+		return f, err
+	}
+
+	r, ok := parseRat(text)
+	if !ok {
+		return 0, fmt.Errorf("invalid number before suffix %q: %q", event.Suffix, value)
+	}
+	r.Mul(r, multiplier)
+	var f float64
+	if bits == 32 {
+		f32, _ := r.Float32()
+		f = float64(f32)
+	} else {
+		f, _ = r.Float64()
+	}
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("value %s with suffix %q out of range for float%d", text, event.Suffix, bits)
+	}
+	if f == 0 && r.Sign() != 0 {
+		event.Underflow = true
+	}
+	return f, nil
+}
+
+// checkFloat applies the float policy to a parsed value, recording precision loss for float32
+func (p *numberParser) checkFloat(f float64, bits int, value string, event *NumberEvent) error {
+	policy := p.opts.Float
+	if math.IsNaN(f) {
+		if policy.RejectNaN {
+			return errors.New("NaN is not allowed")
+		}
+		return nil
+	}
+	if math.IsInf(f, 0) {
+		if policy.RejectInf {
+			return fmt.Errorf("%v is not allowed", f)
+		}
+		return nil
+	}
+
+	if event.Underflow && policy.RejectSubnormal {
+		return fmt.Errorf("value underflows float%d to zero", bits)
+	}
+	smallestNormal := 0x1p-1022
+	if bits == 32 {
+		smallestNormal = 0x1p-126
+	}
+	if f != 0 && math.Abs(f) < smallestNormal && policy.RejectSubnormal {
+		return fmt.Errorf("value %g is subnormal for float%d", f, bits)
+	}
+
+	if bits == 32 {
+		// The float32 loses precision when it is a different number than the exact input;
+		// inputs whose exponent is too large to read exactly cannot be a finite float32
+		exact, ok := p.parseExactFloat(value)
+		event.PrecisionLoss = !ok || exact.Cmp(new(big.Rat).SetFloat64(f)) != 0
+		if event.PrecisionLoss && policy.RejectPrecisionLoss {
+			return fmt.Errorf("value cannot be represented exactly as float32 (nearest is %s)", strconv.FormatFloat(f, 'g', -1, 32))
+		}
+	}
+	return nil
+}
+
+// parseExactFloat reads a float input as an exact rational, following the syntax of
+// parseFloatValue
+func (p *numberParser) parseExactFloat(value string) (*big.Rat, bool) {
+	if !p.opts.Percent {
+		return p.parseExactNumber(value)
+	}
+
+	text := strings.TrimSpace(value)
+	if number, found := strings.CutSuffix(text, "%"); found {
+		r, ok := p.parseExactNumber(strings.TrimSpace(number))
+		if !ok {
+			return nil, false
+		}
+		return r.Quo(r, big.NewRat(100, 1)), true
+	}
+	if numerator, denominator, found := strings.Cut(text, "/"); found {
+		n, ok := p.parseExactNumber(strings.TrimSpace(numerator))
+		if !ok {
+			return nil, false
+		}
+		d, ok := p.parseExactNumber(strings.TrimSpace(denominator))
+		if !ok || d.Sign() == 0 {
+			return nil, false
+		}
+		return n.Quo(n, d), true
+	}
+	return p.parseExactNumber(text)
+}
+
+// parseExactNumber reads one number, applying any suffix, as an exact rational; unlike
+// parseRat it accepts the hexadecimal floats strconv.ParseFloat does
+func (p *numberParser) parseExactNumber(value string) (*big.Rat, bool) {
+	text, multiplier, err := p.normalizeScaled(value, &NumberEvent{})
+	if err != nil || strings.ContainsRune(text, '/') || !exponentInRange(text) {
+		return nil, false
+	}
+	r, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return nil, false
+	}
+	if multiplier != nil {
+		r.Mul(r, multiplier)
+	}
+	return r, true
+}
+
+func (p *numberParser) setBigInt(v reflect.Value, value string, _ *NumberEvent) error {
+	text, err := p.normalize(value)
+	if err != nil {
+		return err
+	}
+	n, err := parseBigInt(text)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(n).Elem())
+	return nil
+}
+
+func (p *numberParser) setBigFloat(v reflect.Value, value string, _ *NumberEvent) error {
+	text, err := p.normalize(value)
+	if err != nil {
+		return err
+	}
+	f, err := parseBigFloat(text, p.opts.BigFloat)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(f).Elem())
+	return nil
+}
+
+func (p *numberParser) setBigRat(v reflect.Value, value string, _ *NumberEvent) error {
+	text, err := p.normalize(value)
+	if err != nil {
+		return err
+	}
+	r, err := parseBigRat(text)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(r).Elem())
+	return nil
+}
+
+func (p *numberParser) setDecimal(v reflect.Value, value string, _ *NumberEvent) error {
+	text, err := p.normalize(value)
+	if err != nil {
+		return err
+	}
+	d, err := decimal.Parse(text)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(d))
+	return nil
+}
+
+// normalizeScaled is like normalize, but also removes an SI or binary suffix when enabled,
+// recording it in event and returning its multiplier (nil when there is no suffix)
+func (p *numberParser) normalizeScaled(value string, event *NumberEvent) (string, *big.Rat, error) {
+	text, suffix, err := p.normalizeSuffix(value, p.opts.SI || p.opts.Binary)
+	if err != nil || suffix == "" {
+		return text, nil, err
+	}
+
+	multiplier := new(big.Rat)
+	if power, ok := binarySuffixes[suffix]; ok {
+		multiplier.SetInt(new(big.Int).Lsh(big.NewInt(1), uint(10*power)))
+	} else {
+		power := siSuffixes[suffix]
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(power))), nil)
+		if power > 0 {
+			multiplier.SetInt(scale)
+		} else {
+			multiplier.SetFrac(big.NewInt(1), scale)
+		}
+	}
+	event.Suffix = suffix
+	event.Multiplier, _ = multiplier.Float64()
+	return text, multiplier, nil
+}
+
+// normalize rewrites value into the plain form understood by strconv: an optional '-',
+// digits, an optional '.' fraction and an optional exponent
+func (p *numberParser) normalize(value string) (string, error) {
+	text, _, err := p.normalizeSuffix(value, false)
+	return text, err
+}
+
+// normalizeSuffix implements normalize, cutting a trailing suffix first when suffixes is set
+func (p *numberParser) normalizeSuffix(value string, suffixes bool) (string, string, error) {
+	format := p.opts.Format
+	text := strings.TrimSpace(value)
+
+	negative := false
+	if format.AccountingNegative && strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		negative = true
+		text = strings.TrimSpace(text[1 : len(text)-1])
+	}
+
+	// A sign may appear on either side of a currency symbol: "-$5" or "$-5"
+	text, signed, minus := cutSign(text)
+	text = trimCurrency(text, format.CurrencySymbols)
+	if !signed {
+		text, signed, minus = cutSign(text)
+	}
+	if minus {
+		if negative {
+			return "", "", errors.New("number has both parentheses and a minus sign")
+		}
+		negative = true
+	}
+	if text == "" {
+		return "", "", errors.New("empty number")
+	}
+
+	suffix := ""
+	if suffixes && !hasBasePrefix(text) {
+		text, suffix = p.cutSuffix(text)
+	}
+
+	// Leave special values such as "Inf" and "NaN", and prefixed integers such as "0xff",
+	// to the type-specific parser
+	first, _ := utf8.DecodeRuneInString(text)
+	if first == '.' && format.decimalSeparator() != '.' && format.GroupSeparator != '.' {
+		return "", "", fmt.Errorf("'.' is not the decimal separator %q", format.decimalSeparator())
+	}
+	if hasBasePrefix(text) || first != format.decimalSeparator() && (first < '0' || first > '9') {
+		return p.sign(negative) + text, suffix, nil
+	}
+
+	mantissa, exponent := text, ""
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		mantissa, exponent = text[:i], text[i:]
+	}
+
+	intPart, fracPart, hasFraction := strings.Cut(mantissa, string(format.decimalSeparator()))
+	if strings.ContainsRune(fracPart, format.decimalSeparator()) {
+		return "", "", fmt.Errorf("number has more than one decimal separator")
+	}
+	intPart, err := format.ungroup(intPart)
+	if err != nil {
+		return "", "", err
+	}
+	if hasFraction && format.GroupSeparator != 0 && strings.ContainsRune(fracPart, format.GroupSeparator) {
+		return "", "", fmt.Errorf("group separator %q in fractional part", format.GroupSeparator)
+	}
+	// strconv would read any other '.' as a decimal point, so "1.234" must not become 1.234
+	// when ',' is the decimal separator
+	if strings.ContainsRune(intPart, '.') || strings.ContainsRune(fracPart, '.') {
+		return "", "", fmt.Errorf("'.' is not the decimal separator %q", format.decimalSeparator())
+	}
+
+	normalized := p.sign(negative) + intPart
+	if hasFraction {
+		normalized += "." + fracPart
+	}
+	return normalized + exponent, suffix, nil
+}
+
+// cutSuffix removes an enabled SI or binary suffix that directly follows a digit or the
+// decimal separator, so that words such as "Inf" are left alone
+func (p *numberParser) cutSuffix(text string) (string, string) {
+	candidates := make([]string, 0, 2)
+	if p.opts.Binary && len(text) > 2 {
+		candidates = append(candidates, text[len(text)-2:])
+	}
+	if p.opts.SI {
+		_, size := utf8.DecodeLastRuneInString(text)
+		candidates = append(candidates, text[len(text)-size:])
+	}
+
+	for _, suffix := range candidates {
+		_, isBinary := binarySuffixes[suffix]
+		_, isSI := siSuffixes[suffix]
+		if !(isBinary && p.opts.Binary) && !(isSI && p.opts.SI) {
+			continue
+		}
+		number := strings.TrimSpace(text[:len(text)-len(suffix)])
+		last, _ := utf8.DecodeLastRuneInString(number)
+		if (last >= '0' && last <= '9') || last == p.opts.Format.decimalSeparator() {
+			return number, suffix
+		}
+	}
+	return text, ""
+}
+
+func (p *numberParser) sign(negative bool) string {
+	if negative {
+		return "-"
+	}
+	return ""
+}
+
+// validate rejects formats that cannot tell decimal and group separators apart
+func (f NumberFormat) validate() error {
+	if f.GroupSeparator == f.decimalSeparator() {
+		return fmt.Errorf("number format uses %q as both decimal and group separator", f.GroupSeparator)
+	}
+	return nil
+}
+
+func (f NumberFormat) decimalSeparator() rune {
+	if f.DecimalSeparator == 0 {
+		return '.'
+	}
+	return f.DecimalSeparator
+}
+
+// ungroup removes group separators from the integer digits, requiring groups of three
+func (f NumberFormat) ungroup(digits string) (string, error) {
+	if f.GroupSeparator == 0 || !strings.ContainsRune(digits, f.GroupSeparator) {
+		return digits, nil
+	}
+	groups := strings.Split(digits, string(f.GroupSeparator))
+	for i, group := range groups {
+		if (i == 0 && (len(group) == 0 || len(group) > 3)) || (i > 0 && len(group) != 3) {
+			return "", fmt.Errorf("misplaced group separator %q in %q", f.GroupSeparator, digits)
+		}
+	}
+	return strings.Join(groups, ""), nil
+}
+
+// cutSign removes a leading '+' or '-'; signed reports whether one was present
+func cutSign(text string) (rest string, signed bool, minus bool) {
+	if text != "" && (text[0] == '+' || text[0] == '-') {
+		return strings.TrimSpace(text[1:]), true, text[0] == '-'
+	}
+	return text, false, false
+}
+
+// trimCurrency removes one currency symbol from the start or end of text
+func trimCurrency(text string, symbols []string) string {
+	for _, symbol := range symbols {
+		if symbol == "" {
+			continue
+		}
+		if strings.HasPrefix(text, symbol) {
+			return strings.TrimSpace(text[len(symbol):])
+		}
+		if strings.HasSuffix(text, symbol) {
+			return strings.TrimSpace(text[:len(text)-len(symbol)])
+		}
+	}
+	return text
+}
+
+// parseRat parses a normalized decimal number exactly. big.Rat also reads ratios and
+// base-prefixed numbers such as "0x1p4", which the strict integer path rejects, so they are
+// rejected here too.
+func parseRat(text string) (*big.Rat, bool) {
+	if strings.ContainsRune(text, '/') || hasBasePrefix(text) || !exponentInRange(text) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(text)
+}
+
+// maxRatExponent is the largest exponent magnitude read exactly as a big.Rat, such as the
+// 100000 in "1e-100000". Such a value takes about 40 KB, while inputs such as "1e999999999"
+// would force huge allocations.
+const maxRatExponent = 100000
+
+// exponentInRange reports whether the decimal exponent of value, or the binary exponent of a
+// base-prefixed value, is at most maxRatExponent in magnitude; exponents that are not
+// integers are left for the parser to reject
+func exponentInRange(value string) bool {
+	markers := "eE"
+	if hasBasePrefix(value) {
+		markers = "pP"
+	}
+	i := strings.IndexAny(value, markers)
+	if i < 0 {
+		return true
+	}
+	exponent, err := strconv.Atoi(value[i+1:])
+	if err != nil {
+		return !errors.Is(err, strconv.ErrRange)
+	}
+	return abs(exponent) <= maxRatExponent
+}
+
+// hasNonZeroDigit reports whether the mantissa of a normalized number has a non-zero digit
+func hasNonZeroDigit(text string) bool {
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		text = text[:i]
+	}
+	return strings.ContainsAny(text, "123456789")
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// setBigInteger stores n in an integer-kinded value, applying the overflow policy to values
+// outside its range
+func (p *numberParser) setBigInteger(v reflect.Value, n *big.Int, event *NumberEvent) error {
+	bits := uint(v.Type().Bits())
+	signed := v.CanInt()
+
+	lo, hi := new(big.Int), new(big.Int).Lsh(big.NewInt(1), bits)
+	if signed {
+		lo.Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+		hi.Lsh(big.NewInt(1), bits-1)
+	}
+	hi.Sub(hi, big.NewInt(1))
+
+	if n.Cmp(lo) < 0 || n.Cmp(hi) > 0 {
+		switch p.opts.Overflow {
+		case OverflowSaturate:
+			if n.Sign() < 0 {
+				n = lo
+			} else {
+				n = hi
+			}
+			event.Saturated = true
+		case OverflowWrap:
+			n = new(big.Int).Mod(n, new(big.Int).Lsh(big.NewInt(1), bits))
+			if signed && n.Cmp(hi) > 0 {
+				n.Sub(n, new(big.Int).Lsh(big.NewInt(1), bits))
+			}
+			event.Wrapped = true
+		default:
+			kind := "unsigned"
+			if signed {
+				kind = "signed"
+			}
+			return fmt.Errorf("value %s out of range for %d-bit %s integer", n, bits, kind)
+		}
+	}
+
+	if signed {
+		v.SetInt(n.Int64())
+	} else {
+		v.SetUint(n.Uint64())
+	}
+	return nil
+}
+
+func (m IntegerRounding) String() string {
+	switch m {
+	case IntegerStrict:
+		return "strict"
+	case IntegerTruncate:
+		return "truncate"
+	case IntegerHalfEven:
+		return "half-even"
+	case IntegerFloor:
+		return "floor"
+	case IntegerCeil:
+		return "ceil"
+	}
+	return fmt.Sprintf("IntegerRounding(%d)", int(m))
+}
+
+func (m OverflowPolicy) String() string {
+	switch m {
+	case OverflowError:
+		return "error"
+	case OverflowSaturate:
+		return "saturate"
+	case OverflowWrap:
+		return "wrap"
+	}
+	return fmt.Sprintf("OverflowPolicy(%d)", int(m))
+}
@@ -0,0 +1,660 @@
+package converter
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/decimal"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+var (
+	europeanFormat = NumberFormat{DecimalSeparator: ',', GroupSeparator: '.', CurrencySymbols: []string{"€", "EUR"}}
+	financeFormat  = NumberFormat{GroupSeparator: ',', CurrencySymbols: []string{"$"}, AccountingNegative: true}
+)
+
+func TestNumberConverterFloat64(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   NumberFormat
+		input    string
+		expected float64
+		hasError bool
+	}{
+		{"default format", NumberFormat{}, "1234.56", 1234.56, false},
+		{"default rejects grouping", NumberFormat{}, "1,234.56", 0, true},
+		{"european", europeanFormat, "1.234,56", 1234.56, false},
+		{"european without grouping", europeanFormat, "1234,56", 1234.56, false},
+		{"european currency suffix", europeanFormat, "1.234,56 €", 1234.56, false},
+		{"european currency code", europeanFormat, "EUR 12,5", 12.5, false},
+		{"european rejects dot decimal", europeanFormat, "1.5", 0, true},
+		{"comma decimal rejects dot", NumberFormat{DecimalSeparator: ','}, "1.5", 0, true},
+		{"comma decimal rejects dot grouping", NumberFormat{DecimalSeparator: ','}, "1.234", 0, true},
+		{"comma decimal rejects leading dot", NumberFormat{DecimalSeparator: ','}, ".5", 0, true},
+		{"space grouping rejects dot", NumberFormat{DecimalSeparator: ',', GroupSeparator: ' '}, "1.234", 0, true},
+		{"space grouping", NumberFormat{DecimalSeparator: ',', GroupSeparator: ' '}, "1 234,5", 1234.5, false},
+		{"finance", financeFormat, "1,234.56", 1234.56, false},
+		{"finance millions", financeFormat, "1,234,567", 1234567, false},
+		{"finance currency", financeFormat, "$1,234.56", 1234.56, false},
+		{"accounting negative", financeFormat, "(1,234.56)", -1234.56, false},
+		{"accounting negative with currency", financeFormat, "($12)", -12, false},
+		{"minus before currency", financeFormat, "-$12", -12, false},
+		{"minus after currency", financeFormat, "$-12", -12, false},
+		{"surrounding spaces", financeFormat, "  42  ", 42, false},
+		{"exponent", financeFormat, "1.5e3", 1500, false},
+		{"infinity", financeFormat, "-Inf", math.Inf(-1), false},
+		{"misplaced group", financeFormat, "12,34.5", 0, true},
+		{"group in fraction", financeFormat, "1.234,5", 0, true},
+		{"two decimal separators", financeFormat, "1.2.3", 0, true},
+		{"parentheses and minus", financeFormat, "(-12)", 0, true},
+		{"only currency", financeFormat, "$", 0, true},
+		{"empty string", financeFormat, "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter, err := NewNumberConverter(reflect.TypeOf(float64(0)), NumberOptions{Format: tt.format})
+			if err != nil {
+				t.Fatalf("NewNumberConverter unexpected error: %v", err)
+			}
+			result, err := converter(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+				if result != nil {
+					t.Errorf("converter(%q) expected nil result, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("converter(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("converter(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberFormatSameSeparators(t *testing.T) {
+	for _, format := range []NumberFormat{
+		{DecimalSeparator: ',', GroupSeparator: ','},
+		{GroupSeparator: '.'},
+	} {
+		if _, err := NewNumberConverter(reflect.TypeOf(float64(0)), NumberOptions{Format: format}); err == nil {
+			t.Errorf("NewNumberConverter(%+v) expected error, got nil", format)
+		}
+		converters := NumberConverters(NumberOptions{Format: format})
+		if result, err := converters[reflect.TypeOf(int(0))]("1,234"); err == nil {
+			t.Errorf("NumberConverters(%+v) converter expected error, got %v", format, result)
+		}
+	}
+}
+
+func TestNumberConvertersExactTypes(t *testing.T) {
+	converters := NumberConverters(NumberOptions{Format: financeFormat})
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"(1,234)", int(-1234)},
+		{"-128", int8(-128)},
+		{"32,767", int16(32767)},
+		{"$2,147,483,647", int32(2147483647)},
+		{"(9,223,372,036,854,775,808)", int64(-9223372036854775808)},
+		{"1,000", uint(1000)},
+		{"255", uint8(255)},
+		{"65,535", uint16(65535)},
+		{"4,294,967,295", uint32(4294967295)},
+		{"18,446,744,073,709,551,615", uint64(18446744073709551615)},
+		{"1,234.5", float32(1234.5)},
+		{"(0.25)", float64(-0.25)},
+		{"$19.99", decimal.MustParse("19.99")},
+	}
+
+	for _, tt := range tests {
+		targetType := reflect.TypeOf(tt.expected)
+		result, err := converters[targetType](tt.input)
+		if err != nil {
+			t.Errorf("%s converter(%q) unexpected error: %v", targetType, tt.input, err)
+			continue
+		}
+		if result != tt.expected {
+			t.Errorf("%s converter(%q) = %#v, expected %#v", targetType, tt.input, result, tt.expected)
+		}
+
+		ptrResult, err := converters[reflect.PointerTo(targetType)](tt.input)
+		if err != nil {
+			t.Errorf("*%s converter(%q) unexpected error: %v", targetType, tt.input, err)
+			continue
+		}
+		if reflect.ValueOf(ptrResult).Elem().Interface() != tt.expected {
+			t.Errorf("*%s converter(%q) = %v, expected %v", targetType, tt.input, ptrResult, tt.expected)
+		}
+	}
+}
+
+func TestNumberConvertersBigTypes(t *testing.T) {
+	converters := NumberConverters(NumberOptions{Format: europeanFormat})
+
+	result, err := converters[reflect.TypeOf(&big.Int{})]("123.456.789.012.345.678.901.234")
+	if err != nil || result.(*big.Int).String() != "123456789012345678901234" {
+		t.Errorf("*big.Int converter = %v, %v", result, err)
+	}
+
+	result, err = converters[reflect.TypeOf(&big.Int{})]("0xff")
+	if err != nil || result.(*big.Int).Int64() != 255 {
+		t.Errorf("*big.Int converter(0xff) = %v, %v", result, err)
+	}
+
+	result, err = converters[reflect.TypeOf(big.Rat{})]("1.234,5 €")
+	if err != nil {
+		t.Fatalf("big.Rat converter unexpected error: %v", err)
+	}
+	rat := result.(big.Rat)
+	if rat.Cmp(big.NewRat(2469, 2)) != 0 {
+		t.Errorf("big.Rat converter = %s, expected 2469/2", rat.String())
+	}
+
+	result, err = converters[reflect.TypeOf(&big.Float{})]("-0,5")
+	if err != nil {
+		t.Fatalf("*big.Float converter unexpected error: %v", err)
+	}
+	if f, _ := result.(*big.Float).Float64(); f != -0.5 {
+		t.Errorf("*big.Float converter = %v, expected -0.5", f)
+	}
+	if prec := result.(*big.Float).Prec(); prec != DefaultBigFloatPrecision {
+		t.Errorf("*big.Float converter precision = %d, expected %d", prec, DefaultBigFloatPrecision)
+	}
+
+	// Precision and rounding come from BigFloat
+	converters = NumberConverters(NumberOptions{Format: europeanFormat, BigFloat: BigFloatOptions{Precision: 8, Rounding: big.ToZero}})
+	result, err = converters[reflect.TypeOf(&big.Float{})]("1.000,9")
+	if err != nil {
+		t.Fatalf("*big.Float converter unexpected error: %v", err)
+	}
+	if f := result.(*big.Float); f.Prec() != 8 || f.Mode() != big.ToZero || f.Text('f', 0) != "1000" {
+		t.Errorf("*big.Float converter = %s (prec %d, %v), expected 1000 (prec 8, ToZero)", f.Text('g', -1), f.Prec(), f.Mode())
+	}
+}
+
+func TestNumberConverterRange(t *testing.T) {
+	converters := NumberConverters(NumberOptions{})
+
+	tests := []struct {
+		input      string
+		targetType reflect.Type
+	}{
+		{"128", reflect.TypeOf(int8(0))},
+		{"-129", reflect.TypeOf(int8(0))},
+		{"256", reflect.TypeOf(uint8(0))},
+		{"-1", reflect.TypeOf(uint(0))},
+		{"18446744073709551616", reflect.TypeOf(uint64(0))},
+		{"1.5", reflect.TypeOf(int(0))},
+		{"1e39", reflect.TypeOf(float32(0))},
+	}
+
+	for _, tt := range tests {
+		result, err := converters[tt.targetType](tt.input)
+		var conversionErr *model.ConversionError
+		if !errors.As(err, &conversionErr) {
+			t.Errorf("%s converter(%q) = %v, %v; expected *model.ConversionError", tt.targetType, tt.input, result, err)
+			continue
+		}
+		if conversionErr.Type != tt.targetType || conversionErr.Value != tt.input {
+			t.Errorf("%s converter(%q) error = %+v", tt.targetType, tt.input, conversionErr)
+		}
+	}
+}
+
+func TestNewNumberConverterNamedTypes(t *testing.T) {
+	type Cents int64
+	type Ratio float32
+
+	converter, err := NewNumberConverter(reflect.TypeOf(Cents(0)), NumberOptions{Format: financeFormat})
+	if err != nil {
+		t.Fatalf("NewNumberConverter unexpected error: %v", err)
+	}
+	if result, err := converter("(1,250)"); err != nil || result != Cents(-1250) {
+		t.Errorf("Cents converter = %#v, %v; expected Cents(-1250)", result, err)
+	}
+
+	converter, err = NewNumberConverter(reflect.TypeOf(new(Ratio)), NumberOptions{Format: europeanFormat})
+	if err != nil {
+		t.Fatalf("NewNumberConverter unexpected error: %v", err)
+	}
+	if result, err := converter("0,5"); err != nil || *result.(*Ratio) != 0.5 {
+		t.Errorf("*Ratio converter = %v, %v; expected 0.5", result, err)
+	}
+
+	for _, targetType := range []reflect.Type{reflect.TypeOf(""), reflect.TypeOf(true), reflect.TypeOf(complex128(0)), reflect.TypeOf([]int{})} {
+		if _, err := NewNumberConverter(targetType, NumberOptions{}); err == nil {
+			t.Errorf("NewNumberConverter(%s) expected error, got nil", targetType)
+		}
+	}
+}
+
+func TestNumberConverterSuffixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     NumberOptions
+		input    string
+		expected interface{}
+		suffix   string
+		hasError bool
+	}{
+		{"kilo", NumberOptions{SI: true}, "1.5k", int(1500), "k", false},
+		{"upper kilo", NumberOptions{SI: true}, "2K", uint16(2000), "K", false},
+		{"mega", NumberOptions{SI: true}, "3M", int64(3000000), "M", false},
+		{"milli float", NumberOptions{SI: true}, "250m", float64(0.25), "m", false},
+		{"micro float", NumberOptions{SI: true}, "5µ", float64(5e-6), "µ", false},
+		{"space before suffix", NumberOptions{SI: true}, "10 G", float64(1e10), "G", false},
+		{"negative", NumberOptions{SI: true}, "-2k", int16(-2000), "k", false},
+		{"no suffix", NumberOptions{SI: true}, "42", int(42), "", false},
+		{"infinity is not femto", NumberOptions{SI: true}, "Inf", math.Inf(1), "", false},
+		{"locale format", NumberOptions{SI: true, Format: europeanFormat}, "1,5k", int(1500), "k", false},
+		{"currency and suffix", NumberOptions{SI: true, Format: financeFormat}, "$2.5M", float32(2.5e6), "M", false},
+		{"binary", NumberOptions{Binary: true}, "1.5Ki", int(1536), "Ki", false},
+		{"binary gibi", NumberOptions{Binary: true}, "4Gi", uint64(4 << 30), "Gi", false},
+		{"binary and SI", NumberOptions{SI: true, Binary: true}, "2Mi", int(2 << 20), "Mi", false},
+		{"SI not enabled", NumberOptions{Binary: true}, "2k", int(0), "", true},
+		{"binary not enabled", NumberOptions{SI: true}, "2Ki", int(0), "", true},
+		{"suffixes disabled", NumberOptions{}, "2k", int(0), "", true},
+		{"not integral", NumberOptions{SI: true}, "250m", int(0), "", true},
+		{"fractional kilo", NumberOptions{SI: true}, "1.0005k", int(0), "", true},
+		{"overflow", NumberOptions{SI: true}, "200k", int16(0), "", true},
+		{"negative unsigned", NumberOptions{SI: true}, "-1k", uint(0), "", true},
+		{"binary overflow", NumberOptions{Binary: true}, "16Ei", uint64(0), "", true},
+		{"float32 overflow", NumberOptions{SI: true}, "1e30E", float32(0), "", true},
+		{"suffix only", NumberOptions{SI: true}, "k", int(0), "", true},
+		{"unknown suffix", NumberOptions{SI: true}, "5x", int(0), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []NumberEvent
+			opts := tt.opts
+			opts.Observe = func(event NumberEvent) { events = append(events, event) }
+			converter, err := NewNumberConverter(reflect.TypeOf(tt.expected), opts)
+			if err != nil {
+				t.Fatalf("NewNumberConverter unexpected error: %v", err)
+			}
+			result, err := converter(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+				if len(events) != 0 {
+					t.Errorf("converter(%q) should not report events for failed conversions", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("converter(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("converter(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+			}
+			if len(events) != 1 || events[0].Suffix != tt.suffix || events[0].Input != tt.input {
+				t.Errorf("converter(%q) events = %+v, expected suffix %q", tt.input, events, tt.suffix)
+			}
+		})
+	}
+}
+
+func TestNumberConverterSuffixErrors(t *testing.T) {
+	converter, _ := NewNumberConverter(reflect.TypeOf(int8(0)), NumberOptions{SI: true})
+
+	_, err := converter("1k")
+	if err == nil || !strings.Contains(err.Error(), `suffix "k"`) {
+		t.Errorf("converter(%q) error = %v, expected it to mention the suffix", "1k", err)
+	}
+
+	_, err = converter("5m")
+	if err == nil || !strings.Contains(err.Error(), `suffix "m"`) {
+		t.Errorf("converter(%q) error = %v, expected it to mention the suffix", "5m", err)
+	}
+
+	var event NumberEvent
+	converter, _ = NewNumberConverter(reflect.TypeOf(float64(0)), NumberOptions{Binary: true, Observe: func(e NumberEvent) { event = e }})
+	if _, err := converter("1Ki"); err != nil {
+		t.Fatalf("converter(%q) unexpected error: %v", "1Ki", err)
+	}
+	if event.Multiplier != 1024 || event.Type != reflect.TypeOf(float64(0)) {
+		t.Errorf("event = %+v, expected multiplier 1024 for float64", event)
+	}
+}
+
+func TestNumberConverterPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     NumberOptions
+		input    string
+		expected interface{}
+		hasError bool
+	}{
+		{"percent", NumberOptions{Percent: true}, "75%", float64(0.75), false},
+		{"ratio", NumberOptions{Percent: true}, "3/4", float64(0.75), false},
+		{"plain fraction", NumberOptions{Percent: true}, "0.75", float64(0.75), false},
+		{"float32", NumberOptions{Percent: true}, "50%", float32(0.5), false},
+		{"locale format", NumberOptions{Percent: true, Format: europeanFormat}, "12,5 %", float64(0.125), false},
+		{"suffix in ratio", NumberOptions{Percent: true, SI: true}, "1k/4", float64(250), false},
+		{"percent disabled", NumberOptions{}, "75%", float64(0), true},
+		{"ratio disabled", NumberOptions{}, "3/4", float64(0), true},
+		{"zero denominator", NumberOptions{Percent: true}, "3/0", float64(0), true},
+		{"overflowing ratio", NumberOptions{Percent: true}, "1e308/1e-308", float64(0), true},
+		{"integers are unaffected", NumberOptions{Percent: true}, "75%", int(0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter, err := NewNumberConverter(reflect.TypeOf(tt.expected), tt.opts)
+			if err != nil {
+				t.Fatalf("NewNumberConverter unexpected error: %v", err)
+			}
+			result, err := converter(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("converter(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("converter(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNumberConverterOverflowPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    OverflowPolicy
+		input     string
+		expected  interface{}
+		saturated bool
+		wrapped   bool
+		hasError  bool
+	}{
+		{"error", OverflowError, "128", int8(0), false, false, true},
+		{"error in range", OverflowError, "127", int8(127), false, false, false},
+		{"saturate high", OverflowSaturate, "300", int8(127), true, false, false},
+		{"saturate low", OverflowSaturate, "-300", int8(-128), true, false, false},
+		{"saturate unsigned negative", OverflowSaturate, "-1", uint16(0), true, false, false},
+		{"saturate uint64", OverflowSaturate, "99999999999999999999999", uint64(18446744073709551615), true, false, false},
+		{"saturate int64", OverflowSaturate, "-99999999999999999999999", int64(-9223372036854775808), true, false, false},
+		{"saturate in range", OverflowSaturate, "5", int8(5), false, false, false},
+		{"wrap high", OverflowWrap, "128", int8(-128), false, true, false},
+		{"wrap 300", OverflowWrap, "300", int8(44), false, true, false},
+		{"wrap low", OverflowWrap, "-129", int8(127), false, true, false},
+		{"wrap unsigned negative", OverflowWrap, "-1", uint8(255), false, true, false},
+		{"wrap uint16", OverflowWrap, "65537", uint16(1), false, true, false},
+		{"wrap uint64", OverflowWrap, "18446744073709551616", uint64(0), false, true, false},
+		{"wrap in range", OverflowWrap, "-5", int8(-5), false, false, false},
+		{"syntax errors still fail", OverflowSaturate, "12x", int8(0), false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var event NumberEvent
+			converter, _ := NewNumberConverter(reflect.TypeOf(tt.expected), NumberOptions{
+				Overflow: tt.policy,
+				Observe:  func(e NumberEvent) { event = e },
+			})
+			result, err := converter(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("converter(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("converter(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+			}
+			if event.Saturated != tt.saturated || event.Wrapped != tt.wrapped {
+				t.Errorf("converter(%q) event = %+v, expected saturated %v, wrapped %v", tt.input, event, tt.saturated, tt.wrapped)
+			}
+		})
+	}
+}
+
+func TestNumberConverterOverflowWithSuffix(t *testing.T) {
+	converters := NumberConverters(NumberOptions{SI: true, Overflow: OverflowSaturate})
+
+	result, err := converters[reflect.TypeOf(new(int16))]("40k")
+	if err != nil || *result.(*int16) != 32767 {
+		t.Errorf("*int16 converter(%q) = %v, %v; expected 32767", "40k", result, err)
+	}
+
+	// The policy only affects integers
+	if _, err := converters[reflect.TypeOf(float32(0))]("1e39"); err == nil {
+		t.Errorf("float32 converter(%q) expected error, got nil", "1e39")
+	}
+}
+
+func TestOverflowPolicyString(t *testing.T) {
+	for policy, expected := range map[OverflowPolicy]string{
+		OverflowError:     "error",
+		OverflowSaturate:  "saturate",
+		OverflowWrap:      "wrap",
+		OverflowPolicy(9): "OverflowPolicy(9)",
+	} {
+		if result := policy.String(); result != expected {
+			t.Errorf("OverflowPolicy(%d).String() = %q, expected %q", int(policy), result, expected)
+		}
+	}
+}
+
+func TestNumberConverterFloatPolicy(t *testing.T) {
+	strict := FloatPolicy{RejectNaN: true, RejectInf: true, RejectPrecisionLoss: true, RejectSubnormal: true}
+
+	tests := []struct {
+		name          string
+		policy        FloatPolicy
+		input         string
+		expected      interface{}
+		precisionLoss bool
+		hasError      bool
+	}{
+		{"NaN allowed", FloatPolicy{}, "NaN", nil, false, false},
+		{"NaN rejected", strict, "NaN", float64(0), false, true},
+		{"Inf allowed", FloatPolicy{}, "-Inf", math.Inf(-1), false, false},
+		{"Inf rejected", strict, "+Inf", float64(0), false, true},
+		{"float32 Inf rejected", strict, "Inf", float32(0), false, true},
+		{"exact float32", strict, "0.5", float32(0.5), false, false},
+		{"exact decimal float32", strict, "0.100000001490116119384765625", float32(0.1), false, false},
+		{"exact hex float32", strict, "0x1.99999ap-4", float32(0.1), false, false},
+		{"short decimal precision loss detected", FloatPolicy{}, "0.1", float32(0.1), true, false},
+		{"short decimal precision loss rejected", strict, "0.1", float32(0), false, true},
+		{"float32 integer limit", strict, "16777216", float32(16777216), false, false},
+		{"float32 precision loss detected", FloatPolicy{}, "16777217", float32(16777216), true, false},
+		{"float32 precision loss rejected", strict, "16777217", float32(0), false, true},
+		{"float32 long fraction", strict, "3.14159265358979", float32(0), false, true},
+		{"float64 long fraction", strict, "3.14159265358979", float64(3.14159265358979), false, false},
+		{"float32 overflow", FloatPolicy{}, "1e39", float32(0), false, true},
+		{"float64 overflow", FloatPolicy{}, "1e309", float64(0), false, true},
+		{"float32 subnormal allowed", FloatPolicy{}, "1e-40", float32(1e-40), true, false},
+		{"float32 subnormal rejected", strict, "1e-40", float32(0), false, true},
+		{"float64 subnormal rejected", strict, "1e-310", float64(0), false, true},
+		{"float32 underflow allowed", FloatPolicy{}, "1e-50", float32(0), true, false},
+		{"float32 underflow rejected", FloatPolicy{RejectSubnormal: true}, "1e-50", float32(0), false, true},
+		{"float64 underflow rejected", FloatPolicy{RejectSubnormal: true}, "1e-400", float64(0), false, true},
+		{"zero is not underflow", strict, "0.000", float64(0), false, false},
+		{"zero exponent is not underflow", strict, "0e-500", float32(0), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetType := reflect.TypeOf(tt.expected)
+			if tt.expected == nil {
+				targetType = reflect.TypeOf(float64(0))
+			}
+
+			var event NumberEvent
+			converters := NumberConverters(NumberOptions{Float: tt.policy, Observe: func(e NumberEvent) { event = e }})
+			result, err := converters[targetType](tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+				// Pointer converters apply the same policy
+				if ptrResult, err := converters[reflect.PointerTo(targetType)](tt.input); err == nil {
+					t.Errorf("pointer converter(%q) expected error, got %v", tt.input, ptrResult)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("converter(%q) unexpected error: %v", tt.input, err)
+			}
+			if tt.expected == nil {
+				if !math.IsNaN(result.(float64)) {
+					t.Errorf("converter(%q) = %v, expected NaN", tt.input, result)
+				}
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("converter(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+			}
+			if event.PrecisionLoss != tt.precisionLoss {
+				t.Errorf("converter(%q) PrecisionLoss = %v, expected %v", tt.input, event.PrecisionLoss, tt.precisionLoss)
+			}
+		})
+	}
+}
+
+func TestNumberConverterFloatPolicyWithPercent(t *testing.T) {
+	converter, _ := NewNumberConverter(reflect.TypeOf(float32(0)), NumberOptions{
+		Percent: true,
+		Float:   FloatPolicy{RejectPrecisionLoss: true},
+	})
+
+	if result, err := converter("50%"); err != nil || result != float32(0.5) {
+		t.Errorf("converter(%q) = %v, %v; expected 0.5", "50%", result, err)
+	}
+	if result, err := converter("1/8"); err != nil || result != float32(0.125) {
+		t.Errorf("converter(%q) = %v, %v; expected 0.125", "1/8", result, err)
+	}
+	if _, err := converter("10%"); err == nil {
+		t.Errorf("converter(%q) expected precision loss error, got nil", "10%")
+	}
+	if _, err := converter("1/3"); err == nil {
+		t.Errorf("converter(%q) expected precision loss error, got nil", "1/3")
+	}
+}
+
+func TestNumberConverterLenientIntegers(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding IntegerRounding
+		input    string
+		expected interface{}
+		rounded  bool
+		hasError bool
+	}{
+		{"integral decimal", IntegerStrict, "42.0", int(42), false, false},
+		{"exponent", IntegerStrict, "1e3", int(1000), false, false},
+		{"fractional exponent", IntegerStrict, "1.5e2", uint16(150), false, false},
+		{"negative exponent integral", IntegerStrict, "1200e-2", int8(12), false, false},
+		{"plain integer", IntegerStrict, "-7", int32(-7), false, false},
+		{"strict fraction", IntegerStrict, "42.5", int(0), false, true},
+		{"strict small exponent", IntegerStrict, "1e-3", int(0), false, true},
+		{"truncate", IntegerTruncate, "42.9", int(42), true, false},
+		{"truncate negative", IntegerTruncate, "-42.9", int(-42), true, false},
+		{"half-even down", IntegerHalfEven, "2.5", int(2), true, false},
+		{"half-even up", IntegerHalfEven, "3.5", int(4), true, false},
+		{"half-even negative", IntegerHalfEven, "-2.5", int(-2), true, false},
+		{"half-even nearest", IntegerHalfEven, "2.51", int(3), true, false},
+		{"floor", IntegerFloor, "2.1", int(2), true, false},
+		{"floor negative", IntegerFloor, "-2.1", int(-3), true, false},
+		{"ceil", IntegerCeil, "2.1", uint(3), true, false},
+		{"ceil negative", IntegerCeil, "-2.1", int(-2), true, false},
+		{"rounding then overflow", IntegerCeil, "127.5", int8(0), false, true},
+		{"too large", IntegerStrict, "1e400", int64(0), false, true},
+		{"huge exponent", IntegerStrict, "1e999999999", int64(0), false, true},
+		{"ratio", IntegerStrict, "4/2", int(0), false, true},
+		{"hex", IntegerStrict, "0x10", int64(0), false, true},
+		{"hex float", IntegerStrict, "0x1p4", int64(0), false, true},
+		{"negative binary", IntegerTruncate, "-0b11", int(0), false, true},
+		{"infinity", IntegerTruncate, "Inf", int(0), false, true},
+		{"not a number", IntegerStrict, "4x", int(0), false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var event NumberEvent
+			converter, _ := NewNumberConverter(reflect.TypeOf(tt.expected), NumberOptions{
+				LenientIntegers: true,
+				IntegerRounding: tt.rounding,
+				Observe:         func(e NumberEvent) { event = e },
+			})
+			result, err := converter(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("converter(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("converter(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+			}
+			if event.Rounded != tt.rounded {
+				t.Errorf("converter(%q) Rounded = %v, expected %v", tt.input, event.Rounded, tt.rounded)
+			}
+		})
+	}
+}
+
+func TestNumberConverterLenientIntegersErrors(t *testing.T) {
+	strict, _ := NewNumberConverter(reflect.TypeOf(int(0)), NumberOptions{})
+	if _, err := strict("42.0"); err == nil {
+		t.Errorf("converter(%q) without LenientIntegers expected error, got nil", "42.0")
+	}
+
+	lenient, _ := NewNumberConverter(reflect.TypeOf(int(0)), NumberOptions{LenientIntegers: true})
+	_, err := lenient("42.5")
+	if err == nil || !strings.Contains(err.Error(), "fractional part would be discarded") {
+		t.Errorf("converter(%q) error = %v, expected it to explain the discarded fraction", "42.5", err)
+	}
+
+	// Rounding also applies to fractional results of suffixes
+	rounded, _ := NewNumberConverter(reflect.TypeOf(int(0)), NumberOptions{SI: true, IntegerRounding: IntegerHalfEven})
+	if result, err := rounded("1.2345k"); err != nil || result != 1234 {
+		t.Errorf("converter(%q) = %v, %v; expected 1234", "1.2345k", result, err)
+	}
+}
+
+func TestIntegerRoundingString(t *testing.T) {
+	for rounding, expected := range map[IntegerRounding]string{
+		IntegerStrict:      "strict",
+		IntegerTruncate:    "truncate",
+		IntegerHalfEven:    "half-even",
+		IntegerFloor:       "floor",
+		IntegerCeil:        "ceil",
+		IntegerRounding(9): "IntegerRounding(9)",
+	} {
+		if result := rounding.String(); result != expected {
+			t.Errorf("IntegerRounding(%d).String() = %q, expected %q", int(rounding), result, expected)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// Percent is a ratio stored as a fraction, so 75% is Percent(0.75)
+type Percent float64
+
+// PercentOptions configures percent parsing
+type PercentOptions struct {
+	// PlainPercent reads numbers without a '%' sign as percentages, so "75" is 75%;
+	// by default they are fractions, so "0.75" is 75%
+	PlainPercent bool
+	// Bounded rejects values outside 0% to 100%, i.e. fractions outside [0, 1]
+	// or, with PlainPercent, plain numbers outside [0, 100]
+	Bounded bool
+}
+
+func init() {
+	registerConverter(reflect.TypeOf(Percent(0)), StringToPercent)
+	registerConverter(reflect.TypeOf(new(Percent)), StringToPercentPtr)
+}
+
+// String formats p as a percentage such as "75%", using the fewest digits that
+// StringToPercent reads back as p. Fractions that no percentage reads back as exactly,
+// such as 1/3, get every digit of p*100 and read back within a rounding error.
+func (p Percent) String() string {
+	for _, precision := range []int{15, 16, 17} {
+		text := strconv.FormatFloat(float64(p)*100, 'g', precision, 64)
+		if f, err := strconv.ParseFloat(text, 64); err == nil && Percent(f/100) == p {
+			return text + "%"
+		}
+	}
+	return strconv.FormatFloat(float64(p)*100, 'g', -1, 64) + "%"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (p Percent) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// StringToPercent converts "75%", "0.75" or "3/4" to Percent(0.75)
+func StringToPercent(value string) (interface{}, error) {
+	return NewPercentConverter(PercentOptions{})(value)
+}
+
+func StringToPercentPtr(value string) (interface{}, error) {
+	return NewPercentPtrConverter(PercentOptions{})(value)
+}
+
+// NewPercentConverter returns a Percent converter that applies opts
+func NewPercentConverter(opts PercentOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		p, err := parsePercent(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+}
+
+// NewPercentPtrConverter returns a *Percent converter that applies opts
+func NewPercentPtrConverter(opts PercentOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		p, err := parsePercent(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &p, nil
+	}
+}
+
+// PercentConverters returns Percent and *Percent converters built from opts, ready for RegisterAll
+func PercentConverters(opts PercentOptions) map[reflect.Type]model.ConverterFunc {
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(Percent(0)):   NewPercentConverter(opts),
+		reflect.TypeOf(new(Percent)): NewPercentPtrConverter(opts),
+	}
+}
+
+func parsePercent(value string, opts PercentOptions) (Percent, error) {
+	plainScale := 1.0
+	if opts.PlainPercent {
+		plainScale = 100
+	}
+
+	fraction, err := parseFraction(value, plainScale, func(text string) (float64, error) {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, err
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, errors.New("percent must be a finite number")
+		}
+		return f, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("invalid percent %q: %w", value, err)
+	}
+	// Percentages must format back as a finite number of percent
+	if math.IsInf(fraction*100, 0) {
+		return 0, fmt.Errorf("percent %q is out of range", value)
+	}
+	if opts.Bounded && (fraction < 0 || fraction > 1) {
+		return 0, fmt.Errorf("percent %q is outside 0%% to 100%%", value)
+	}
+	return Percent(fraction), nil
+}
+
+// parseFraction reads "75%", "3/4" or a plain number divided by plainScale as a fraction,
+// using parse for each number
+func parseFraction(value string, plainScale float64, parse func(string) (float64, error)) (float64, error) {
+	text := strings.TrimSpace(value)
+
+	if number, found := strings.CutSuffix(text, "%"); found {
+		f, err := parse(strings.TrimSpace(number))
+		if err != nil {
+			return 0, err
+		}
+		return f / 100, nil
+	}
+
+	if numerator, denominator, found := strings.Cut(text, "/"); found {
+		n, err := parse(strings.TrimSpace(numerator))
+		if err != nil {
+			return 0, err
+		}
+		d, err := parse(strings.TrimSpace(denominator))
+		if err != nil {
+			return 0, err
+		}
+		if d == 0 {
+			return 0, errors.New("ratio has a zero denominator")
+		}
+		// Finite numbers such as 1e308/1e-308 can overflow when divided
+		q := n / d
+		if math.IsInf(q, 0) && !math.IsInf(n, 0) {
+			return 0, errors.New("ratio is out of range")
+		}
+		return q, nil
+	}
+
+	f, err := parse(text)
+	if err != nil {
+		return 0, err
+	}
+	return f / plainScale, nil
+}
@@ -0,0 +1,152 @@
+package converter
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestStringToPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Percent
+		hasError bool
+	}{
+		{"percent sign", "75%", 0.75, false},
+		{"fraction", "0.75", 0.75, false},
+		{"ratio", "3/4", 0.75, false},
+		{"ratio with spaces", " 1 / 8 ", 0.125, false},
+		{"space before sign", "12.5 %", 0.125, false},
+		{"over one hundred percent", "150%", 1.5, false},
+		{"negative", "-5%", -0.05, false},
+		{"zero", "0%", 0, false},
+		{"zero denominator", "1/0", 0, true},
+		{"overflowing ratio", "1e308/1e-308", 0, true},
+		{"too large to format", "1e307", 0, true},
+		{"double sign", "5%%", 0, true},
+		{"not a number", "abc%", 0, true},
+		{"infinite", "Inf%", 0, true},
+		{"not a number value", "NaN", 0, true},
+		{"empty string", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToPercent(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToPercent(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToPercent(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToPercent(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("StringToPercent(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToPercentPtr(t *testing.T) {
+	result, err := StringToPercentPtr("40%")
+	if err != nil {
+		t.Fatalf("StringToPercentPtr unexpected error: %v", err)
+	}
+	if *result.(*Percent) != 0.4 {
+		t.Errorf("StringToPercentPtr = %v, expected 0.4", *result.(*Percent))
+	}
+
+	result, err = StringToPercentPtr("oops")
+	if err == nil || result != nil {
+		t.Errorf("StringToPercentPtr(%q) = %v, %v; expected nil result and an error", "oops", result, err)
+	}
+}
+
+func TestPercentOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     PercentOptions
+		input    string
+		expected Percent
+		hasError bool
+	}{
+		{"plain percent", PercentOptions{PlainPercent: true}, "75", 0.75, false},
+		{"plain percent keeps sign form", PercentOptions{PlainPercent: true}, "75%", 0.75, false},
+		{"plain percent keeps ratio form", PercentOptions{PlainPercent: true}, "3/4", 0.75, false},
+		{"bounded fraction", PercentOptions{Bounded: true}, "1", 1, false},
+		{"bounded fraction above one", PercentOptions{Bounded: true}, "1.01", 0, true},
+		{"bounded percent above hundred", PercentOptions{Bounded: true}, "101%", 0, true},
+		{"bounded negative", PercentOptions{Bounded: true}, "-1%", 0, true},
+		{"bounded ratio above one", PercentOptions{Bounded: true}, "5/4", 0, true},
+		{"bounded plain percent", PercentOptions{PlainPercent: true, Bounded: true}, "100", 1, false},
+		{"bounded plain percent above hundred", PercentOptions{PlainPercent: true, Bounded: true}, "100.5", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := PercentConverters(tt.opts)[reflect.TypeOf(Percent(0))](tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("converter(%q) expected error, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("converter(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("converter(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestPercentString(t *testing.T) {
+	tests := []struct {
+		input    Percent
+		expected string
+	}{
+		{0.75, "75%"},
+		{0.07, "7%"},
+		{0.125, "12.5%"},
+		{1.5, "150%"},
+		{-0.05, "-5%"},
+		{0, "0%"},
+		// 15 significant digits would read back as a different fraction
+		{1.0 / 7919, "0.012627857052658164%"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.input.String(); result != tt.expected {
+			t.Errorf("Percent(%v).String() = %q, expected %q", float64(tt.input), result, tt.expected)
+		}
+		text, _ := tt.input.MarshalText()
+		if string(text) != tt.expected {
+			t.Errorf("Percent(%v).MarshalText() = %q, expected %q", float64(tt.input), text, tt.expected)
+		}
+
+		// Formatted values parse back to the same fraction
+		parsed, err := StringToPercent(tt.expected)
+		if err != nil || parsed != tt.input {
+			t.Errorf("StringToPercent(%q) = %v, %v; expected %v", tt.expected, parsed, err, tt.input)
+		}
+	}
+
+	// No percentage reads back as exactly 1/3, so every digit is kept
+	third := Percent(1.0 / 3)
+	if result := third.String(); result != "33.33333333333333%" {
+		t.Errorf("Percent(1/3).String() = %q, expected %q", result, "33.33333333333333%")
+	}
+	parsed, err := StringToPercent(third.String())
+	if err != nil || math.Abs(float64(parsed.(Percent)-third)) > 1e-15 {
+		t.Errorf("StringToPercent(%q) = %v, %v; expected about %v", third.String(), parsed, err, third)
+	}
+}
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// RegexpOptions configures how patterns are compiled
+type RegexpOptions struct {
+	// Anchored requires the pattern to match the whole input, as if wrapped in ^(?:...)$
+	Anchored bool
+	// POSIX restricts the syntax to POSIX ERE and uses leftmost-longest matching
+	POSIX bool
+}
+
+func init() {
+	var regexpVar regexp.Regexp
+	registerConverter(reflect.TypeOf(regexpVar), StringToRegexp)
+	registerConverter(reflect.TypeOf(&regexpVar), StringToRegexpPtr)
+}
+
+func StringToRegexp(value string) (interface{}, error) {
+	return NewRegexpConverter(RegexpOptions{})(value)
+}
+
+func StringToRegexpPtr(value string) (interface{}, error) {
+	return NewRegexpPtrConverter(RegexpOptions{})(value)
+}
+
+// NewRegexpConverter returns a regexp.Regexp converter that compiles patterns using opts
+func NewRegexpConverter(opts RegexpOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		re, err := compileRegexp(value, opts, reflect.TypeOf(regexp.Regexp{}))
+		if err != nil {
+			return nil, err
+		}
+		return *re, nil
+	}
+}
+
+// NewRegexpPtrConverter returns a *regexp.Regexp converter that compiles patterns using opts
+func NewRegexpPtrConverter(opts RegexpOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		re, err := compileRegexp(value, opts, reflect.TypeOf(&regexp.Regexp{}))
+		if err != nil {
+			return nil, err
+		}
+		return re, nil
+	}
+}
+
+// RegexpConverters returns regexp.Regexp and *regexp.Regexp converters built from opts, ready for RegisterAll
+func RegexpConverters(opts RegexpOptions) map[reflect.Type]model.ConverterFunc {
+	var regexpVar regexp.Regexp
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(regexpVar):  NewRegexpConverter(opts),
+		reflect.TypeOf(&regexpVar): NewRegexpPtrConverter(opts),
+	}
+}
+
+// compileRegexp compiles value, reporting syntax errors as *model.ConversionError
+// with the offset of the offending expression
+func compileRegexp(value string, opts RegexpOptions, targetType reflect.Type) (*regexp.Regexp, error) {
+	flags := syntax.Perl
+	if opts.POSIX {
+		flags = syntax.POSIX
+	}
+
+	// Parse the pattern as written so that error offsets refer to the user's input
+	tree, err := syntax.Parse(value, flags)
+	if err != nil {
+		return nil, regexpError(value, targetType, err)
+	}
+
+	var re *regexp.Regexp
+	switch {
+	case opts.Anchored:
+		// POSIX syntax has no non-capturing groups, so anchor the parsed tree instead of the text
+		re, err = regexp.Compile(`^(?:` + tree.String() + `)$`)
+		if err == nil && opts.POSIX {
+			re.Longest()
+		}
+	case opts.POSIX:
+		re, err = regexp.CompilePOSIX(value)
+	default:
+		re, err = regexp.Compile(value)
+	}
+	if err != nil {
+		return nil, regexpError(value, targetType, err)
+	}
+	return re, nil
+}
+
+func regexpError(value string, targetType reflect.Type, err error) error {
+	offset := -1
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) {
+		switch syntaxErr.Code {
+		case syntax.ErrMissingParen, syntax.ErrUnexpectedParen:
+			// Expr holds the whole pattern for these, so locate the unbalanced parenthesis instead
+			offset = unbalancedParen(value)
+		default:
+			if syntaxErr.Expr != "" {
+				offset = strings.Index(value, syntaxErr.Expr)
+			}
+		}
+	}
+	return &model.ConversionError{
+		Value:  value,
+		Type:   targetType,
+		Offset: offset,
+		Err:    err,
+	}
+}
+
+// unbalancedParen returns the offset of the first unmatched ")" or, failing that,
+// of the last unclosed "(", skipping escapes and character classes
+func unbalancedParen(value string) int {
+	var open []int
+	inClass := false
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// A "]" directly after "[" or "[^" is a literal
+			if i+1 < len(value) && value[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(value) && value[i+1] == ']' {
+				i++
+			}
+		case c == '(':
+			open = append(open, i)
+		case c == ')':
+			if len(open) == 0 {
+				return i
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if len(open) > 0 {
+		return open[len(open)-1]
+	}
+	return -1
+}
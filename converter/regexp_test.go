@@ -0,0 +1,134 @@
+package converter
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+func TestStringToRegexpPtr(t *testing.T) {
+	result, err := StringToRegexpPtr(`^v\d+\.\d+$`)
+	if err != nil {
+		t.Fatalf("StringToRegexpPtr unexpected error: %v", err)
+	}
+	re := result.(*regexp.Regexp)
+	if !re.MatchString("v1.2") || re.MatchString("1.2") {
+		t.Errorf("StringToRegexpPtr compiled %q with unexpected matching behavior", re)
+	}
+
+	if result, err := StringToRegexpPtr("a(b"); err == nil || result != nil {
+		t.Errorf("StringToRegexpPtr expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestStringToRegexp(t *testing.T) {
+	result, err := StringToRegexp("err(or)?")
+	if err != nil {
+		t.Fatalf("StringToRegexp unexpected error: %v", err)
+	}
+	re := result.(regexp.Regexp)
+	if !re.MatchString("an error occurred") {
+		t.Errorf("StringToRegexp compiled %q with unexpected matching behavior", re.String())
+	}
+}
+
+func TestRegexpErrorOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		code   syntax.ErrorCode
+		offset int
+	}{
+		{"missing closing paren", "ab(cd", syntax.ErrMissingParen, 2},
+		{"innermost unclosed paren", "(a)(b(c)", syntax.ErrMissingParen, 3},
+		{"unexpected paren", "ab)c", syntax.ErrUnexpectedParen, 2},
+		{"escaped paren ignored", `\(a)`, syntax.ErrUnexpectedParen, 3},
+		{"paren in class ignored", "[)]a)", syntax.ErrUnexpectedParen, 4},
+		{"nested repetition", "ab**", syntax.ErrInvalidRepeatOp, 2},
+		{"bad class range", "x[z-a]", syntax.ErrInvalidCharRange, 2},
+		{"bad escape", `ok\q`, syntax.ErrInvalidEscape, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := StringToRegexpPtr(tt.input)
+
+			var conversionErr *model.ConversionError
+			if !errors.As(err, &conversionErr) {
+				t.Fatalf("StringToRegexpPtr(%q) error = %v, expected *model.ConversionError", tt.input, err)
+			}
+			if conversionErr.Offset != tt.offset {
+				t.Errorf("StringToRegexpPtr(%q) offset = %d, expected %d", tt.input, conversionErr.Offset, tt.offset)
+			}
+			if conversionErr.Value != tt.input || conversionErr.Type != reflect.TypeOf(&regexp.Regexp{}) {
+				t.Errorf("StringToRegexpPtr(%q) error has value %q and type %v", tt.input, conversionErr.Value, conversionErr.Type)
+			}
+
+			var syntaxErr *syntax.Error
+			if !errors.As(err, &syntaxErr) || syntaxErr.Code != tt.code {
+				t.Errorf("StringToRegexpPtr(%q) error should wrap syntax error %q, got %v", tt.input, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestNewRegexpConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     RegexpOptions
+		pattern  string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"unanchored", RegexpOptions{}, "a|ab", "xab", "a", false},
+		{"anchored matches whole input", RegexpOptions{Anchored: true}, "a|ab", "ab", "ab", false},
+		{"anchored rejects partial", RegexpOptions{Anchored: true}, "a|ab", "xab", "", false},
+		{"POSIX leftmost longest", RegexpOptions{POSIX: true}, "a|ab", "xab", "ab", false},
+		{"POSIX anchored", RegexpOptions{POSIX: true, Anchored: true}, "(a|ab)(c|bcd)", "abcd", "abcd", false},
+		{"POSIX rejects Perl syntax", RegexpOptions{POSIX: true}, `\d+`, "", "", true},
+		{"POSIX anchored rejects Perl syntax", RegexpOptions{POSIX: true, Anchored: true}, `(?:a)`, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewRegexpPtrConverter(tt.opts)(tt.pattern)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("NewRegexpPtrConverter(%q) expected error, got nil", tt.pattern)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewRegexpPtrConverter(%q) unexpected error: %v", tt.pattern, err)
+			}
+			if got := result.(*regexp.Regexp).FindString(tt.input); got != tt.expected {
+				t.Errorf("FindString(%q) = %q, expected %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegexpConverters(t *testing.T) {
+	var regexpVar regexp.Regexp
+	converters := RegexpConverters(RegexpOptions{Anchored: true})
+
+	result, err := converters[reflect.TypeOf(&regexpVar)]("[a-z]+")
+	if err != nil || result.(*regexp.Regexp).MatchString("abc1") {
+		t.Errorf("*regexp.Regexp converter should be anchored, got %v, %v", result, err)
+	}
+
+	result, err = converters[reflect.TypeOf(regexpVar)]("[a-z]+")
+	if err != nil {
+		t.Fatalf("regexp.Regexp converter unexpected error: %v", err)
+	}
+	re := result.(regexp.Regexp)
+	if !re.MatchString("abc") {
+		t.Error("regexp.Regexp converter should match a full input")
+	}
+}
@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"database/sql"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+func init() {
+	registerConverter(reflect.TypeOf(sql.NullString{}), StringToNullString)
+	registerConverter(reflect.TypeOf(sql.NullInt64{}), StringToNullInt64)
+	registerConverter(reflect.TypeOf(sql.NullBool{}), StringToNullBool)
+	registerConverter(reflect.TypeOf(sql.NullFloat64{}), StringToNullFloat64)
+	registerConverter(reflect.TypeOf(sql.NullTime{}), StringToNullTime)
+}
+
+// StringToNullString converts value to a valid sql.NullString; the empty string is kept as
+// a valid empty string, so use the registry's null tokens to map it to NULL
+func StringToNullString(value string) (interface{}, error) {
+	return sql.NullString{String: value, Valid: true}, nil
+}
+
+// StringToNullInt64 converts value to a sql.NullInt64; the empty string is NULL
+func StringToNullInt64(value string) (interface{}, error) {
+	if value == "" {
+		return sql.NullInt64{}, nil
+	}
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NullInt64{Int64: i, Valid: true}, nil
+}
+
+// StringToNullBool converts value to a sql.NullBool; the empty string is NULL
+func StringToNullBool(value string) (interface{}, error) {
+	if value == "" {
+		return sql.NullBool{}, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NullBool{Bool: b, Valid: true}, nil
+}
+
+// StringToNullFloat64 converts value to a sql.NullFloat64; the empty string is NULL
+func StringToNullFloat64(value string) (interface{}, error) {
+	if value == "" {
+		return sql.NullFloat64{}, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NullFloat64{Float64: f, Valid: true}, nil
+}
+
+// StringToNullTime converts value to a sql.NullTime using the StringToTime layouts; the empty string is NULL
+func StringToNullTime(value string) (interface{}, error) {
+	if value == "" {
+		return sql.NullTime{}, nil
+	}
+	t, err := StringToTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NullTime{Time: t.(time.Time), Valid: true}, nil
+}
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestStringToNullString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected sql.NullString
+	}{
+		{"hello", sql.NullString{String: "hello", Valid: true}},
+		{"", sql.NullString{String: "", Valid: true}},
+	}
+
+	for _, tt := range tests {
+		result, err := StringToNullString(tt.input)
+		if err != nil {
+			t.Errorf("StringToNullString(%q) unexpected error: %v", tt.input, err)
+		}
+		if result != tt.expected {
+			t.Errorf("StringToNullString(%q) = %v, expected %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestStringToNullInt64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected sql.NullInt64
+		hasError bool
+	}{
+		{"number", "42", sql.NullInt64{Int64: 42, Valid: true}, false},
+		{"negative", "-9223372036854775808", sql.NullInt64{Int64: -9223372036854775808, Valid: true}, false},
+		{"empty is null", "", sql.NullInt64{}, false},
+		{"overflow", "9223372036854775808", sql.NullInt64{}, true},
+		{"invalid", "abc", sql.NullInt64{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToNullInt64(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToNullInt64(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToNullInt64(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToNullInt64(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("StringToNullInt64(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToNullBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected sql.NullBool
+		hasError bool
+	}{
+		{"true", "true", sql.NullBool{Bool: true, Valid: true}, false},
+		{"false", "0", sql.NullBool{Bool: false, Valid: true}, false},
+		{"empty is null", "", sql.NullBool{}, false},
+		{"invalid", "maybe", sql.NullBool{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToNullBool(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToNullBool(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToNullBool(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToNullBool(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("StringToNullBool(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToNullFloat64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected sql.NullFloat64
+		hasError bool
+	}{
+		{"number", "3.25", sql.NullFloat64{Float64: 3.25, Valid: true}, false},
+		{"empty is null", "", sql.NullFloat64{}, false},
+		{"invalid", "pi", sql.NullFloat64{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringToNullFloat64(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("StringToNullFloat64(%q) expected error, got nil", tt.input)
+				}
+				if result != nil {
+					t.Errorf("StringToNullFloat64(%q) expected nil result, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("StringToNullFloat64(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("StringToNullFloat64(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStringToNullTime(t *testing.T) {
+	result, err := StringToNullTime("2024-03-01T10:30:00Z")
+	if err != nil {
+		t.Fatalf("StringToNullTime unexpected error: %v", err)
+	}
+	expected := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+	if nullTime := result.(sql.NullTime); !nullTime.Valid || !nullTime.Time.Equal(expected) {
+		t.Errorf("StringToNullTime = %v, expected %v", nullTime, expected)
+	}
+
+	result, err = StringToNullTime("")
+	if err != nil || result != (sql.NullTime{}) {
+		t.Errorf("StringToNullTime(%q) = %v, %v; expected NULL", "", result, err)
+	}
+
+	result, err = StringToNullTime("yesterday")
+	if err == nil || result != nil {
+		t.Errorf("StringToNullTime(%q) = %v, %v; expected nil result and an error", "yesterday", result, err)
+	}
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// URLOptions configures validation applied by a URL converter
+type URLOptions struct {
+	// Schemes restricts the accepted schemes, compared case-insensitively; empty allows any scheme
+	Schemes []string
+	// RequireAbsolute rejects relative references such as "/path" or "example.com"
+	RequireAbsolute bool
+}
+
+func init() {
+	registerConverter(reflect.TypeOf(url.URL{}), StringToURL)
+	registerConverter(reflect.TypeOf(&url.URL{}), StringToURLPtr)
+}
+
+func StringToURL(value string) (interface{}, error) {
+	return NewURLConverter(URLOptions{})(value)
+}
+
+func StringToURLPtr(value string) (interface{}, error) {
+	return NewURLPtrConverter(URLOptions{})(value)
+}
+
+// NewURLConverter returns a url.URL converter that validates against opts
+func NewURLConverter(opts URLOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		u, err := parseURL(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return *u, nil
+	}
+}
+
+// NewURLPtrConverter returns a *url.URL converter that validates against opts
+func NewURLPtrConverter(opts URLOptions) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		u, err := parseURL(value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return u, nil
+	}
+}
+
+// URLConverters returns url.URL and *url.URL converters built from opts, ready for RegisterAll
+func URLConverters(opts URLOptions) map[reflect.Type]model.ConverterFunc {
+	return map[reflect.Type]model.ConverterFunc{
+		reflect.TypeOf(url.URL{}):  NewURLConverter(opts),
+		reflect.TypeOf(&url.URL{}): NewURLPtrConverter(opts),
+	}
+}
+
+func parseURL(value string, opts URLOptions) (*url.URL, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if (opts.RequireAbsolute || len(opts.Schemes) > 0) && !u.IsAbs() {
+		return nil, fmt.Errorf("invalid URL %q: must be absolute", value)
+	}
+	if len(opts.Schemes) > 0 {
+		allowed := false
+		for _, scheme := range opts.Schemes {
+			if strings.EqualFold(u.Scheme, scheme) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, fmt.Errorf("invalid URL %q: scheme %q is not one of %s", value, u.Scheme, strings.Join(opts.Schemes, ", "))
+		}
+	}
+	return u, nil
+}
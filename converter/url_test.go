@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestStringToURL(t *testing.T) {
+	result, err := StringToURL("https://example.com:8443/api?x=1#top")
+	if err != nil {
+		t.Fatalf("StringToURL unexpected error: %v", err)
+	}
+	u := result.(url.URL)
+	if u.Scheme != "https" || u.Host != "example.com:8443" || u.Path != "/api" || u.RawQuery != "x=1" || u.Fragment != "top" {
+		t.Errorf("StringToURL parsed unexpected parts: %#v", u)
+	}
+
+	result, err = StringToURL("relative/path")
+	if err != nil || result.(url.URL).Path != "relative/path" {
+		t.Errorf("StringToURL should accept relative references by default, got %v, %v", result, err)
+	}
+
+	if result, err := StringToURL("http://[::1"); err == nil || result != nil {
+		t.Errorf("StringToURL expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestStringToURLPtr(t *testing.T) {
+	result, err := StringToURLPtr("postgres://user@db:5432/app")
+	if err != nil {
+		t.Fatalf("StringToURLPtr unexpected error: %v", err)
+	}
+	if u := result.(*url.URL); u.Scheme != "postgres" || u.User.Username() != "user" {
+		t.Errorf("StringToURLPtr parsed unexpected parts: %#v", u)
+	}
+
+	if result, err := StringToURLPtr("%zz"); err == nil || result != nil {
+		t.Errorf("StringToURLPtr expected nil result and error, got %v, %v", result, err)
+	}
+}
+
+func TestNewURLConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     URLOptions
+		input    string
+		hasError bool
+	}{
+		{"absolute allowed", URLOptions{RequireAbsolute: true}, "https://example.com", false},
+		{"relative rejected", URLOptions{RequireAbsolute: true}, "/health", true},
+		{"host without scheme rejected", URLOptions{RequireAbsolute: true}, "example.com", true},
+		{"scheme allowed", URLOptions{Schemes: []string{"http", "https"}}, "https://example.com", false},
+		{"scheme case insensitive", URLOptions{Schemes: []string{"HTTPS"}}, "https://example.com", false},
+		{"scheme rejected", URLOptions{Schemes: []string{"https"}}, "ftp://example.com", true},
+		{"scheme required", URLOptions{Schemes: []string{"https"}}, "//example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewURLConverter(tt.opts)(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("NewURLConverter(%q) expected error, got nil", tt.input)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("NewURLConverter(%q) unexpected error: %v", tt.input, err)
+				}
+				if _, ok := result.(url.URL); !ok {
+					t.Errorf("NewURLConverter(%q) returned %T, expected url.URL", tt.input, result)
+				}
+			}
+		})
+	}
+}
+
+func TestURLConverters(t *testing.T) {
+	converters := URLConverters(URLOptions{Schemes: []string{"https"}})
+
+	result, err := converters[reflect.TypeOf(&url.URL{})]("https://example.com")
+	if err != nil || result.(*url.URL).Host != "example.com" {
+		t.Errorf("*url.URL converter returned %v, %v", result, err)
+	}
+
+	if _, err := converters[reflect.TypeOf(url.URL{})]("http://example.com"); err == nil {
+		t.Error("url.URL converter should apply the scheme restriction")
+	}
+}
@@ -0,0 +1,386 @@
+// Package decimal provides a fixed-point decimal type that keeps the exact digits and
+// scale of its input, for values such as prices where binary floats introduce rounding errors.
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// MaxScale is the largest number of digits a Decimal can hold after the decimal point
+const MaxScale = 18
+
+// MaxExponent is the largest exponent magnitude accepted in scientific notation, such as the
+// 9999 in "1e-9999", so that inputs such as "1e999999999" cannot force huge allocations
+const MaxExponent = 9999
+
+// ErrOverflow is returned when a result does not fit in a Decimal
+var ErrOverflow = errors.New("decimal overflow")
+
+// Decimal is a fixed-point number equal to coefficient × 10^-scale.
+// The coefficient is an int64, so a Decimal holds up to 18 significant digits exactly.
+// The zero value is 0 with scale 0.
+type Decimal struct {
+	coef  int64
+	scale uint8
+}
+
+// RoundingMode selects how digits are discarded when reducing the scale
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the even neighbour
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero
+	RoundHalfUp
+	// RoundDown truncates toward zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity
+	RoundCeiling
+)
+
+// New returns coefficient × 10^-scale
+func New(coefficient int64, scale int) (Decimal, error) {
+	return fromBig(big.NewInt(coefficient), scale)
+}
+
+// Parse converts a decimal string such as "-12.50" or "1.5e3" to a Decimal, keeping
+// trailing zeros as part of the scale
+func Parse(value string) (Decimal, error) {
+	text := value
+	negative := false
+	if text != "" && (text[0] == '+' || text[0] == '-') {
+		negative = text[0] == '-'
+		text = text[1:]
+	}
+
+	mantissa, exponentText, hasExponent := strings.Cut(strings.ToLower(text), "e")
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", value)
+	}
+
+	scale := len(fracPart)
+	if hasExponent {
+		exponent, ok := parseExponent(exponentText)
+		if !ok {
+			return Decimal{}, fmt.Errorf("invalid decimal exponent: %q", value)
+		}
+		scale -= exponent
+	}
+
+	coef, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		coef.Neg(coef)
+	}
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+
+	d, err := fromBig(coef, scale)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("decimal %q: %w", value, err)
+	}
+	return d, nil
+}
+
+// MustParse is like Parse but panics if the value cannot be parsed
+func MustParse(value string) Decimal {
+	d, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Coefficient returns the unscaled value
+func (d Decimal) Coefficient() int64 {
+	return d.coef
+}
+
+// Scale returns the number of digits after the decimal point
+func (d Decimal) Scale() int {
+	return int(d.scale)
+}
+
+// Sign returns -1, 0 or +1
+func (d Decimal) Sign() int {
+	switch {
+	case d.coef < 0:
+		return -1
+	case d.coef > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether d is zero at any scale
+func (d Decimal) IsZero() bool {
+	return d.coef == 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: -d.coef, scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	if d.coef < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// Cmp compares the numeric values of d and e, ignoring scale, and returns -1, 0 or +1
+func (d Decimal) Cmp(e Decimal) int {
+	a, b := d.alignedWith(e)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and e have the same numeric value, so 1.5 equals 1.50
+func (d Decimal) Equal(e Decimal) bool {
+	return d.Cmp(e) == 0
+}
+
+// Add returns d + e at the larger of the two scales
+func (d Decimal) Add(e Decimal) (Decimal, error) {
+	a, b := d.alignedWith(e)
+	return fromBig(a.Add(a, b), max(d.Scale(), e.Scale()))
+}
+
+// Sub returns d - e at the larger of the two scales
+func (d Decimal) Sub(e Decimal) (Decimal, error) {
+	a, b := d.alignedWith(e)
+	return fromBig(a.Sub(a, b), max(d.Scale(), e.Scale()))
+}
+
+// Mul returns d × e. The result scale is the sum of both scales; when that exceeds
+// MaxScale the product is rounded half-even to MaxScale.
+func (d Decimal) Mul(e Decimal) (Decimal, error) {
+	product := new(big.Int).Mul(big.NewInt(d.coef), big.NewInt(e.coef))
+	scale := d.Scale() + e.Scale()
+	if scale > MaxScale {
+		product = roundBig(product, scale-MaxScale, RoundHalfEven)
+		scale = MaxScale
+	}
+	return fromBig(product, scale)
+}
+
+// Div returns d / e rounded to scale digits after the decimal point using mode
+func (d Decimal) Div(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if e.coef == 0 {
+		return Decimal{}, errors.New("decimal division by zero")
+	}
+	if scale < 0 || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("decimal scale %d out of range [0, %d]", scale, MaxScale)
+	}
+
+	// d/e = (d.coef × 10^(scale + extra + e.scale - d.scale)) / e.coef × 10^-(scale + extra),
+	// computed with one extra digit and a sticky remainder so rounding sees the exact value
+	shift := scale + 1 + e.Scale() - d.Scale()
+	numerator := big.NewInt(d.coef)
+	denominator := big.NewInt(e.coef)
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	quotient.Mul(quotient, big.NewInt(10))
+	if remainder.Sign() != 0 {
+		// The discarded tail is non-zero; nudge the extra digits so ties are not mistaken for exact halves
+		if numerator.Sign()*denominator.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return fromBig(roundBig(quotient, 2, mode), scale)
+}
+
+// Round returns d with exactly scale digits after the decimal point, rounding with mode
+// when digits are removed and padding with zeros when they are added
+func (d Decimal) Round(scale int, mode RoundingMode) (Decimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("decimal scale %d out of range [0, %d]", scale, MaxScale)
+	}
+	coef := big.NewInt(d.coef)
+	if scale >= d.Scale() {
+		return fromBig(coef.Mul(coef, pow10(scale-d.Scale())), scale)
+	}
+	return fromBig(roundBig(coef, d.Scale()-scale, mode), scale)
+}
+
+// String formats d with exactly Scale digits after the decimal point
+func (d Decimal) String() string {
+	digits := big.NewInt(d.coef)
+	text := digits.Abs(digits).String()
+	if d.scale > 0 {
+		if len(text) <= int(d.scale) {
+			text = strings.Repeat("0", int(d.scale)-len(text)+1) + text
+		}
+		point := len(text) - int(d.scale)
+		text = text[:point] + "." + text[point:]
+	}
+	if d.coef < 0 {
+		text = "-" + text
+	}
+	return text
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON encodes d as a JSON string so that no precision is lost in transit
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts either a JSON string or a JSON number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if text == "null" {
+		return nil
+	}
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+	return d.UnmarshalText([]byte(text))
+}
+
+// alignedWith returns the coefficients of d and e scaled to the larger of their scales
+func (d Decimal) alignedWith(e Decimal) (*big.Int, *big.Int) {
+	a, b := big.NewInt(d.coef), big.NewInt(e.coef)
+	switch {
+	case d.scale < e.scale:
+		a.Mul(a, pow10(e.Scale()-d.Scale()))
+	case d.scale > e.scale:
+		b.Mul(b, pow10(d.Scale()-e.Scale()))
+	}
+	return a, b
+}
+
+// fromBig builds a Decimal, reporting ErrOverflow when coef or scale is out of range
+func fromBig(coef *big.Int, scale int) (Decimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("%w: scale %d out of range [0, %d]", ErrOverflow, scale, MaxScale)
+	}
+	// math.MinInt64 is excluded so that Neg and Abs can never overflow
+	if !coef.IsInt64() || coef.Int64() == math.MinInt64 {
+		return Decimal{}, fmt.Errorf("%w: coefficient %s does not fit in 64 bits", ErrOverflow, coef)
+	}
+	return Decimal{coef: coef.Int64(), scale: uint8(scale)}, nil
+}
+
+// roundBig divides coef by 10^digits, rounding the discarded digits with mode
+func roundBig(coef *big.Int, digits int, mode RoundingMode) *big.Int {
+	divisor := pow10(digits)
+	quotient, remainder := new(big.Int).QuoRem(coef, divisor, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	negative := coef.Sign() < 0
+	// Compare twice the discarded part with the divisor to detect halves
+	half := new(big.Int).Abs(remainder)
+	half.Mul(half, big.NewInt(2))
+	halfCmp := half.Cmp(divisor)
+
+	awayFromZero := false
+	switch mode {
+	case RoundHalfEven:
+		awayFromZero = halfCmp > 0 || (halfCmp == 0 && quotient.Bit(0) == 1)
+	case RoundHalfUp:
+		awayFromZero = halfCmp >= 0
+	case RoundDown:
+		awayFromZero = false
+	case RoundUp:
+		awayFromZero = true
+	case RoundFloor:
+		awayFromZero = negative
+	case RoundCeiling:
+		awayFromZero = !negative
+	}
+
+	if awayFromZero {
+		if negative {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+func parseExponent(text string) (int, bool) {
+	if text == "" {
+		return 0, false
+	}
+	negative := false
+	if text[0] == '+' || text[0] == '-' {
+		negative = text[0] == '-'
+		text = text[1:]
+	}
+	if text == "" || strings.Trim(text, "0123456789") != "" {
+		return 0, false
+	}
+	exponent := 0
+	for _, c := range text {
+		exponent = exponent*10 + int(c-'0')
+		if exponent > MaxExponent {
+			return 0, false
+		}
+	}
+	if negative {
+		exponent = -exponent
+	}
+	return exponent, true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
@@ -0,0 +1,263 @@
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		scale    int
+		hasError bool
+	}{
+		{"integer", "42", "42", 0, false},
+		{"keeps trailing zeros", "12.50", "12.50", 2, false},
+		{"negative", "-0.05", "-0.05", 2, false},
+		{"plus sign", "+3.1", "3.1", 1, false},
+		{"leading point", ".5", "0.5", 1, false},
+		{"trailing point", "5.", "5", 0, false},
+		{"exponent", "1.5e3", "1500", 0, false},
+		{"negative exponent", "25E-4", "0.0025", 4, false},
+		{"max digits", "999999999999999999", "999999999999999999", 0, false},
+		{"max scale", "0.000000000000000001", "0.000000000000000001", 18, false},
+		{"coefficient overflow", "99999999999999999999", "", 0, true},
+		{"scale overflow", "0.0000000000000000001", "", 0, true},
+		{"exponent overflow", "1e30", "", 0, true},
+		{"padded exponent", "5e0001", "50", 0, false},
+		{"huge exponent", "1e-10000", "", 0, true},
+		{"overflowing exponent", "1e99999999999999999999", "", 0, true},
+		{"empty string", "", "", 0, true},
+		{"sign only", "-", "", 0, true},
+		{"point only", ".", "", 0, true},
+		{"letters", "12a", "", 0, true},
+		{"two points", "1.2.3", "", 0, true},
+		{"bad exponent", "1e", "", 0, true},
+		{"thousands separator", "1,000", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Parse(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Parse(%q) expected error, got %v", tt.input, d)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if d.String() != tt.expected || d.Scale() != tt.scale {
+				t.Errorf("Parse(%q) = %s (scale %d), expected %s (scale %d)", tt.input, d, d.Scale(), tt.expected, tt.scale)
+			}
+		})
+	}
+
+	if _, err := Parse("99999999999999999999"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	d, err := New(-1234, 2)
+	if err != nil || d.String() != "-12.34" {
+		t.Errorf("New(-1234, 2) = %v, %v; expected -12.34", d, err)
+	}
+	if d.Coefficient() != -1234 || d.Scale() != 2 || d.Sign() != -1 {
+		t.Errorf("unexpected accessors: %d, %d, %d", d.Coefficient(), d.Scale(), d.Sign())
+	}
+	if _, err := New(1, MaxScale+1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow for scale beyond MaxScale, got %v", err)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.5", "1.50", 0},
+		{"1.49", "1.5", -1},
+		{"-1", "-1.001", 1},
+		{"0", "0.000", 0},
+		{"999999999999999999", "0.000000000000000001", 1},
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		if got := a.Cmp(b); got != tt.expected {
+			t.Errorf("Cmp(%s, %s) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+		if a.Equal(b) != (tt.expected == 0) {
+			t.Errorf("Equal(%s, %s) = %v", tt.a, tt.b, a.Equal(b))
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b Decimal) (Decimal, error)
+		a, b     string
+		expected string
+		overflow bool
+	}{
+		{"add", Decimal.Add, "0.1", "0.2", "0.3", false},
+		{"add mixed scales", Decimal.Add, "19.99", "0.005", "19.995", false},
+		{"sub", Decimal.Sub, "10.00", "0.01", "9.99", false},
+		{"sub negative", Decimal.Sub, "1", "2.5", "-1.5", false},
+		{"mul", Decimal.Mul, "19.99", "3", "59.97", false},
+		{"mul scales add", Decimal.Mul, "1.10", "1.10", "1.2100", false},
+		{"mul rounds past max scale", Decimal.Mul, "0.000000001", "0.0000000015", "0.000000000000000002", false},
+		{"add overflow", Decimal.Add, "9000000000000000000", "1000000000000000000", "", true},
+		{"mul overflow", Decimal.Mul, "10000000000", "10000000000", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.op(MustParse(tt.a), MustParse(tt.b))
+			if tt.overflow {
+				if !errors.Is(err, ErrOverflow) {
+					t.Errorf("expected ErrOverflow, got %v, %v", result, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("%s(%s, %s) = %s, expected %s", tt.name, tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNegAbs(t *testing.T) {
+	d := MustParse("-2.50")
+	if d.Neg().String() != "2.50" || d.Abs().String() != "2.50" || d.Neg().Neg() != d {
+		t.Errorf("Neg/Abs of %s gave %s and %s", d, d.Neg(), d.Abs())
+	}
+	if !MustParse("0.00").IsZero() || MustParse("0.01").IsZero() {
+		t.Error("IsZero returned unexpected result")
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		input    string
+		scale    int
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.345", 2, RoundHalfEven, "2.34"},
+		{"2.355", 2, RoundHalfEven, "2.36"},
+		{"2.345", 2, RoundHalfUp, "2.35"},
+		{"-2.345", 2, RoundHalfUp, "-2.35"},
+		{"2.349", 2, RoundDown, "2.34"},
+		{"-2.349", 2, RoundDown, "-2.34"},
+		{"2.341", 2, RoundUp, "2.35"},
+		{"-2.341", 2, RoundUp, "-2.35"},
+		{"-2.341", 2, RoundFloor, "-2.35"},
+		{"2.349", 2, RoundFloor, "2.34"},
+		{"-2.349", 2, RoundCeiling, "-2.34"},
+		{"2.341", 2, RoundCeiling, "2.35"},
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"1.5", 3, RoundHalfEven, "1.500"},
+		{"2.340", 2, RoundUp, "2.34"},
+	}
+
+	for _, tt := range tests {
+		result, err := MustParse(tt.input).Round(tt.scale, tt.mode)
+		if err != nil {
+			t.Errorf("Round(%s, %d, %s) unexpected error: %v", tt.input, tt.scale, tt.mode, err)
+			continue
+		}
+		if result.String() != tt.expected {
+			t.Errorf("Round(%s, %d, %s) = %s, expected %s", tt.input, tt.scale, tt.mode, result, tt.expected)
+		}
+	}
+
+	if _, err := MustParse("1").Round(-1, RoundHalfEven); err == nil {
+		t.Error("Round should reject negative scales")
+	}
+	if _, err := MustParse("999999999999999999").Round(2, RoundHalfEven); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Round should report overflow when padding, got %v", err)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		scale    int
+		mode     RoundingMode
+		expected string
+	}{
+		{"10", "3", 2, RoundHalfEven, "3.33"},
+		{"20", "3", 2, RoundHalfEven, "6.67"},
+		{"-10", "3", 2, RoundFloor, "-3.34"},
+		{"1", "8", 2, RoundHalfEven, "0.12"},
+		{"1", "8", 2, RoundHalfUp, "0.13"},
+		{"1.0000001", "8", 2, RoundHalfEven, "0.13"},
+		{"-1", "8", 2, RoundHalfEven, "-0.12"},
+		{"0.1", "0.003", 4, RoundDown, "33.3333"},
+		{"100", "0.25", 0, RoundHalfEven, "400"},
+	}
+
+	for _, tt := range tests {
+		result, err := MustParse(tt.a).Div(MustParse(tt.b), tt.scale, tt.mode)
+		if err != nil {
+			t.Errorf("Div(%s, %s) unexpected error: %v", tt.a, tt.b, err)
+			continue
+		}
+		if result.String() != tt.expected {
+			t.Errorf("Div(%s, %s, %d, %s) = %s, expected %s", tt.a, tt.b, tt.scale, tt.mode, result, tt.expected)
+		}
+	}
+
+	if _, err := MustParse("1").Div(MustParse("0.00"), 2, RoundHalfEven); err == nil {
+		t.Error("Div should reject division by zero")
+	}
+}
+
+func TestMarshaling(t *testing.T) {
+	type invoice struct {
+		Total Decimal  `json:"total"`
+		Tax   *Decimal `json:"tax"`
+	}
+
+	tax := MustParse("1.90")
+	data, err := json.Marshal(invoice{Total: MustParse("19.90"), Tax: &tax})
+	if err != nil {
+		t.Fatalf("json.Marshal unexpected error: %v", err)
+	}
+	if string(data) != `{"total":"19.90","tax":"1.90"}` {
+		t.Errorf("json.Marshal = %s", data)
+	}
+
+	var decoded invoice
+	if err := json.Unmarshal([]byte(`{"total":19.90,"tax":"0.10"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal unexpected error: %v", err)
+	}
+	if decoded.Total.String() != "19.90" || decoded.Tax.String() != "0.10" {
+		t.Errorf("json.Unmarshal = %s, %s", decoded.Total, decoded.Tax)
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":"abc"}`), &decoded); err == nil {
+		t.Error("json.Unmarshal should reject invalid decimals")
+	}
+
+	text, err := MustParse("-0.5").MarshalText()
+	if err != nil || string(text) != "-0.5" {
+		t.Errorf("MarshalText = %s, %v", text, err)
+	}
+	var d Decimal
+	if err := d.UnmarshalText([]byte("7.25")); err != nil || d.String() != "7.25" {
+		t.Errorf("UnmarshalText = %s, %v", d, err)
+	}
+}
@@ -0,0 +1,35 @@
+package formatter
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+var (
+	once         sync.Once
+	formatterMap map[reflect.Type]model.FormatterFunc
+)
+
+func init() {
+	getFormatterMap()
+}
+
+func getFormatterMap() map[reflect.Type]model.FormatterFunc {
+	once.Do(func() {
+		formatterMap = make(map[reflect.Type]model.FormatterFunc)
+	})
+	return formatterMap
+}
+
+// GetFormatterMap returns the default formatters. Types that implement encoding.TextMarshaler
+// or fmt.Stringer, and pointers to formattable types, are handled by typeregistry.FormatRegistry
+// and need no entry here.
+func GetFormatterMap() map[reflect.Type]model.FormatterFunc {
+	return getFormatterMap()
+}
+
+func registerFormatter(sourceType reflect.Type, formatter model.FormatterFunc) {
+	getFormatterMap()[sourceType] = formatter
+}
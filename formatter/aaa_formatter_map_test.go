@@ -0,0 +1,29 @@
+package formatter
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestGetFormatterMap(t *testing.T) {
+	// Reset the map for testing, restoring the defaults for the round-trip tests
+	saved := formatterMap
+	t.Cleanup(func() { formatterMap = saved })
+	once = sync.Once{}
+	formatterMap = nil
+
+	result := getFormatterMap()
+	if result == nil {
+		t.Error("getFormatterMap should return a non-nil map")
+	}
+
+	// Test that subsequent calls return the same map by mutating and checking
+	result2 := GetFormatterMap()
+	type testType struct{}
+	key := reflect.TypeOf(testType{})
+	result[key] = func(interface{}) (string, error) { return "ok", nil }
+	if result2[key] == nil {
+		t.Error("GetFormatterMap should return the same map instance (mutation not visible)")
+	}
+}
@@ -0,0 +1,80 @@
+package formatter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func init() {
+	registerFormatter(reflect.TypeOf(false), FormatBool)
+	registerFormatter(reflect.TypeOf(""), FormatString)
+	for _, t := range []reflect.Type{
+		reflect.TypeOf(int(0)), reflect.TypeOf(int8(0)), reflect.TypeOf(int16(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+	} {
+		registerFormatter(t, FormatInt)
+	}
+	for _, t := range []reflect.Type{
+		reflect.TypeOf(uint(0)), reflect.TypeOf(uint8(0)), reflect.TypeOf(uint16(0)), reflect.TypeOf(uint32(0)), reflect.TypeOf(uint64(0)),
+	} {
+		registerFormatter(t, FormatUint)
+	}
+	registerFormatter(reflect.TypeOf(float32(0)), FormatFloat)
+	registerFormatter(reflect.TypeOf(float64(0)), FormatFloat)
+	registerFormatter(reflect.TypeOf(complex64(0)), FormatComplex)
+	registerFormatter(reflect.TypeOf(complex128(0)), FormatComplex)
+}
+
+// FormatBool formats any bool-kinded value as "true" or "false"
+func FormatBool(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Bool {
+		return "", fmt.Errorf("cannot format %T as a bool", value)
+	}
+	return strconv.FormatBool(v.Bool()), nil
+}
+
+// FormatString returns any string-kinded value unchanged
+func FormatString(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return "", fmt.Errorf("cannot format %T as a string", value)
+	}
+	return v.String(), nil
+}
+
+// FormatInt formats any signed integer in base 10
+func FormatInt(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.CanInt() {
+		return "", fmt.Errorf("cannot format %T as a signed integer", value)
+	}
+	return strconv.FormatInt(v.Int(), 10), nil
+}
+
+// FormatUint formats any unsigned integer in base 10
+func FormatUint(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.CanUint() {
+		return "", fmt.Errorf("cannot format %T as an unsigned integer", value)
+	}
+	return strconv.FormatUint(v.Uint(), 10), nil
+}
+
+// FormatFloat formats any float with the fewest digits that parse back to the same value
+func FormatFloat(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.CanFloat() {
+		return "", fmt.Errorf("cannot format %T as a float", value)
+	}
+	return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+}
+
+// FormatComplex formats any complex number as "(1+2i)"
+func FormatComplex(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.CanComplex() {
+		return "", fmt.Errorf("cannot format %T as a complex number", value)
+	}
+	return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()), nil
+}
@@ -0,0 +1,53 @@
+package formatter
+
+import (
+	"math"
+	"testing"
+)
+
+type testLevel int8
+
+func TestBasicFormatters(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   func(interface{}) (string, error)
+		input    interface{}
+		expected string
+		hasError bool
+	}{
+		{"bool", FormatBool, true, "true", false},
+		{"string", FormatString, "text", "text", false},
+		{"int", FormatInt, -42, "-42", false},
+		{"int64 from int8 converter", FormatInt, int64(-128), "-128", false},
+		{"named int", FormatInt, testLevel(3), "3", false},
+		{"uint", FormatUint, uint64(math.MaxUint64), "18446744073709551615", false},
+		{"float64", FormatFloat, 0.1, "0.1", false},
+		{"float32 shortest", FormatFloat, float32(0.1), "0.1", false},
+		{"infinity", FormatFloat, math.Inf(-1), "-Inf", false},
+		{"complex", FormatComplex, complex(1.5, -2), "(1.5-2i)", false},
+		{"bool mismatch", FormatBool, 1, "", true},
+		{"int mismatch", FormatInt, uint(1), "", true},
+		{"uint mismatch", FormatUint, "1", "", true},
+		{"float mismatch", FormatFloat, 1, "", true},
+		{"complex mismatch", FormatComplex, 1.0, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.format(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Format(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Format(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
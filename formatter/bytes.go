@@ -0,0 +1,29 @@
+package formatter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/converter"
+)
+
+func init() {
+	registerFormatter(reflect.TypeOf([]byte{}), FormatBytes)
+}
+
+// FormatBytes returns the bytes as raw text, adding the "raw:" prefix when the text would
+// otherwise be read as an encoded value by converter.StringToBytes
+func FormatBytes(value interface{}) (string, error) {
+	data, ok := value.([]byte)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as bytes", value)
+	}
+	text := string(data)
+	for _, prefix := range []string{converter.RawPrefix, converter.HexPrefix, converter.Base64Prefix, converter.Base64URLPrefix} {
+		if strings.HasPrefix(text, prefix) {
+			return converter.RawPrefix + text, nil
+		}
+	}
+	return text, nil
+}
@@ -0,0 +1,52 @@
+package formatter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+		hasError bool
+	}{
+		{"plain text", []byte("hello"), "hello", false},
+		{"empty", []byte{}, "", false},
+		{"hex prefix", []byte("hex:00"), "raw:hex:00", false},
+		{"base64 prefix", []byte("base64:AA=="), "raw:base64:AA==", false},
+		{"base64url prefix", []byte("base64url:AA"), "raw:base64url:AA", false},
+		{"raw prefix", []byte("raw:x"), "raw:raw:x", false},
+		{"not bytes", "hello", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatBytes(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("FormatBytes(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("FormatBytes(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("FormatBytes(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+
+			decoded, err := converter.StringToBytes(result)
+			if err != nil {
+				t.Fatalf("StringToBytes(%q) unexpected error: %v", result, err)
+			}
+			if !bytes.Equal(decoded.([]byte), tt.input.([]byte)) {
+				t.Errorf("StringToBytes(%q) = %q, expected %q", result, decoded, tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package formatter_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/formatter"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+// samples holds an input for each converter type; every element of a pointer type shares the
+// sample of its element type
+var samples = map[string]string{
+	"[]uint8":             "base64:aGVsbG8=",
+	"big.Float":           "3.14159265358979323846",
+	"big.Int":             "-123456789012345678901234567890",
+	"big.Rat":             "0.125",
+	"bool":                "true",
+	"complex128":          "1.5-2i",
+	"complex64":           "1.5-2i",
+	"converter.MediaType": "text/html; charset=utf-8",
+	"converter.Percent":   "12.5%",
+	"decimal.Decimal":     "-1234.5678",
+	"float32":             "0.1",
+	"float64":             "0.1",
+	"int":                 "-42",
+	"int16":               "-32768",
+	"int32":               "2147483647",
+	"int64":               "-9223372036854775808",
+	"int8":                "-128",
+	"mail.Address":        "Jane Doe <jane@example.com>",
+	"net.HardwareAddr":    "00:00:5e:00:53:01",
+	"net.IP":              "2001:db8::1",
+	"net.IPNet":           "192.168.0.0/24",
+	"net.TCPAddr":         "127.0.0.1:8080",
+	"net.UDPAddr":         "[::1]:53",
+	"netip.Addr":          "192.168.1.1",
+	"netip.AddrPort":      "192.168.1.1:443",
+	"netip.Prefix":        "10.0.0.0/8",
+	"regexp.Regexp":       `^a+b*$`,
+	"sql.NullBool":        "false",
+	"sql.NullFloat64":     "2.5",
+	"sql.NullInt64":       "",
+	"sql.NullString":      "",
+	"sql.NullTime":        "2024-02-29T12:30:45.123456789Z",
+	"string":              "hex:not hex",
+	"time.Duration":       "-1h2m3.004005006s",
+	"time.Time":           "2024-02-29T12:30:45.123456789Z",
+	"uint":                "42",
+	"uint16":              "65535",
+	"uint32":              "4294967295",
+	"uint64":              "18446744073709551615",
+	"uint8":               "255",
+	"url.URL":             "https://user@example.com:8443/a/b?q=1#frag",
+}
+
+// TestFormatRoundTrip tests that Convert(Format(Convert(s))) equals Convert(s) for every converter type
+func TestFormatRoundTrip(t *testing.T) {
+	converters := typeregistry.NewTypeRegistry()
+	converters.RegisterAll(converter.GetConvertorMap())
+	formatters := typeregistry.NewFormatRegistry()
+	formatters.RegisterAllFormatters(formatter.GetFormatterMap())
+
+	for targetType := range converter.GetConvertorMap() {
+		sampleType := targetType
+		if sampleType.Kind() == reflect.Pointer {
+			sampleType = sampleType.Elem()
+		}
+		sample, ok := samples[sampleType.String()]
+		if !ok {
+			t.Errorf("no sample input for %s", targetType)
+			continue
+		}
+
+		t.Run(targetType.String(), func(t *testing.T) {
+			first, err := converters.Convert(sample, targetType)
+			if err != nil {
+				t.Fatalf("Convert(%q) unexpected error: %v", sample, err)
+			}
+			text, err := formatters.Format(first)
+			if err != nil {
+				t.Fatalf("Format(%v) unexpected error: %v", first, err)
+			}
+			second, err := converters.Convert(text, targetType)
+			if err != nil {
+				t.Fatalf("Convert(%q) unexpected error: %v", text, err)
+			}
+			if !sameValue(first, second) {
+				t.Errorf("Convert(%q) = %v, expected %v (formatted from %q)", text, second, first, sample)
+			}
+		})
+	}
+}
+
+// sameValue compares converted values; compiled regular expressions are compared by source
+func sameValue(a, b interface{}) bool {
+	switch re := a.(type) {
+	case regexp.Regexp:
+		other := b.(regexp.Regexp)
+		return re.String() == other.String()
+	case *regexp.Regexp:
+		return re.String() == b.(*regexp.Regexp).String()
+	}
+	return reflect.DeepEqual(a, b)
+}
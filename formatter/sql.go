@@ -0,0 +1,71 @@
+package formatter
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// The sql.Null* formatters return "" for NULL, which the matching converters read back as
+// NULL for every type except sql.NullString
+
+func init() {
+	registerFormatter(reflect.TypeOf(sql.NullString{}), FormatNullString)
+	registerFormatter(reflect.TypeOf(sql.NullInt64{}), FormatNullInt64)
+	registerFormatter(reflect.TypeOf(sql.NullBool{}), FormatNullBool)
+	registerFormatter(reflect.TypeOf(sql.NullFloat64{}), FormatNullFloat64)
+	registerFormatter(reflect.TypeOf(sql.NullTime{}), FormatNullTime)
+}
+
+func FormatNullString(value interface{}) (string, error) {
+	null, ok := value.(sql.NullString)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a sql.NullString", value)
+	}
+	return null.String, nil
+}
+
+func FormatNullInt64(value interface{}) (string, error) {
+	null, ok := value.(sql.NullInt64)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a sql.NullInt64", value)
+	}
+	if !null.Valid {
+		return "", nil
+	}
+	return strconv.FormatInt(null.Int64, 10), nil
+}
+
+func FormatNullBool(value interface{}) (string, error) {
+	null, ok := value.(sql.NullBool)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a sql.NullBool", value)
+	}
+	if !null.Valid {
+		return "", nil
+	}
+	return strconv.FormatBool(null.Bool), nil
+}
+
+func FormatNullFloat64(value interface{}) (string, error) {
+	null, ok := value.(sql.NullFloat64)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a sql.NullFloat64", value)
+	}
+	if !null.Valid {
+		return "", nil
+	}
+	return FormatFloat(null.Float64)
+}
+
+func FormatNullTime(value interface{}) (string, error) {
+	null, ok := value.(sql.NullTime)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a sql.NullTime", value)
+	}
+	if !null.Valid {
+		return "", nil
+	}
+	return FormatTime(null.Time)
+}
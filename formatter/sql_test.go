@@ -0,0 +1,51 @@
+package formatter
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestSQLFormatters(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   func(interface{}) (string, error)
+		input    interface{}
+		expected string
+		hasError bool
+	}{
+		{"string", FormatNullString, sql.NullString{String: "x", Valid: true}, "x", false},
+		{"int64", FormatNullInt64, sql.NullInt64{Int64: -5, Valid: true}, "-5", false},
+		{"int64 null", FormatNullInt64, sql.NullInt64{}, "", false},
+		{"bool", FormatNullBool, sql.NullBool{Bool: true, Valid: true}, "true", false},
+		{"bool null", FormatNullBool, sql.NullBool{}, "", false},
+		{"float64", FormatNullFloat64, sql.NullFloat64{Float64: 0.1, Valid: true}, "0.1", false},
+		{"float64 null", FormatNullFloat64, sql.NullFloat64{}, "", false},
+		{"time", FormatNullTime, sql.NullTime{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}, "2024-01-02T03:04:05Z", false},
+		{"time null", FormatNullTime, sql.NullTime{}, "", false},
+		{"string mismatch", FormatNullString, "x", "", true},
+		{"int64 mismatch", FormatNullInt64, int64(1), "", true},
+		{"bool mismatch", FormatNullBool, true, "", true},
+		{"float64 mismatch", FormatNullFloat64, 1.0, "", true},
+		{"time mismatch", FormatNullTime, time.Time{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.format(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Format(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Format(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
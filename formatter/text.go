@@ -0,0 +1,72 @@
+package formatter
+
+import (
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+)
+
+// Formatters for value types whose String or MarshalText methods have pointer receivers
+
+func init() {
+	registerFormatter(reflect.TypeOf(url.URL{}), FormatURL)
+	registerFormatter(reflect.TypeOf(mail.Address{}), FormatMailAddress)
+	registerFormatter(reflect.TypeOf(regexp.Regexp{}), FormatRegexp)
+	registerFormatter(reflect.TypeOf(big.Int{}), FormatBigInt)
+	registerFormatter(reflect.TypeOf(big.Float{}), FormatBigFloat)
+	registerFormatter(reflect.TypeOf(big.Rat{}), FormatBigRat)
+}
+
+func FormatURL(value interface{}) (string, error) {
+	u, ok := value.(url.URL)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a URL", value)
+	}
+	return u.String(), nil
+}
+
+func FormatMailAddress(value interface{}) (string, error) {
+	address, ok := value.(mail.Address)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a mail address", value)
+	}
+	return address.String(), nil
+}
+
+// FormatRegexp returns the source text of the regular expression
+func FormatRegexp(value interface{}) (string, error) {
+	re, ok := value.(regexp.Regexp)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a regular expression", value)
+	}
+	return re.String(), nil
+}
+
+func FormatBigInt(value interface{}) (string, error) {
+	n, ok := value.(big.Int)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a big.Int", value)
+	}
+	return n.String(), nil
+}
+
+// FormatBigFloat formats with the fewest digits that parse back to the same value at its precision
+func FormatBigFloat(value interface{}) (string, error) {
+	f, ok := value.(big.Float)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a big.Float", value)
+	}
+	return f.Text('g', -1), nil
+}
+
+// FormatBigRat formats as "a/b", or as an integer when the denominator is 1
+func FormatBigRat(value interface{}) (string, error) {
+	r, ok := value.(big.Rat)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a big.Rat", value)
+	}
+	return r.RatString(), nil
+}
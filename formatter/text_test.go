@@ -0,0 +1,52 @@
+package formatter
+
+import (
+	"math/big"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestTextFormatters(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   func(interface{}) (string, error)
+		input    interface{}
+		expected string
+		hasError bool
+	}{
+		{"url", FormatURL, url.URL{Scheme: "https", Host: "example.com", Path: "/a b"}, "https://example.com/a%20b", false},
+		{"mail address", FormatMailAddress, mail.Address{Name: "Jane", Address: "jane@example.com"}, `"Jane" <jane@example.com>`, false},
+		{"regexp", FormatRegexp, *regexp.MustCompile(`^a+$`), `^a+$`, false},
+		{"big int", FormatBigInt, *big.NewInt(-12), "-12", false},
+		{"big float", FormatBigFloat, *big.NewFloat(0.5), "0.5", false},
+		{"big rat", FormatBigRat, *big.NewRat(3, 4), "3/4", false},
+		{"big rat integer", FormatBigRat, *big.NewRat(4, 2), "2", false},
+		{"url pointer", FormatURL, &url.URL{}, "", true},
+		{"mail mismatch", FormatMailAddress, "jane@example.com", "", true},
+		{"regexp mismatch", FormatRegexp, "a", "", true},
+		{"big int mismatch", FormatBigInt, 1, "", true},
+		{"big float mismatch", FormatBigFloat, 1.0, "", true},
+		{"big rat mismatch", FormatBigRat, 1, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.format(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Format(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Format(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
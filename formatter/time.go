@@ -0,0 +1,20 @@
+package formatter
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+func init() {
+	registerFormatter(reflect.TypeOf(time.Time{}), FormatTime)
+}
+
+// FormatTime formats a time.Time as RFC 3339 with nanoseconds, which StringToTime accepts
+func FormatTime(value interface{}) (string, error) {
+	t, ok := value.(time.Time)
+	if !ok {
+		return "", fmt.Errorf("cannot format %T as a time", value)
+	}
+	return t.Format(time.RFC3339Nano), nil
+}
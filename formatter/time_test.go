@@ -0,0 +1,39 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+		hasError bool
+	}{
+		{"utc", time.Date(2024, 2, 29, 12, 30, 45, 0, time.UTC), "2024-02-29T12:30:45Z", false},
+		{"nanoseconds", time.Date(2024, 2, 29, 12, 30, 45, 500, time.UTC), "2024-02-29T12:30:45.0000005Z", false},
+		{"offset", time.Date(2024, 2, 29, 12, 0, 0, 0, time.FixedZone("", 5*3600+1800)), "2024-02-29T12:00:00+05:30", false},
+		{"not a time", "2024-02-29", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatTime(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("FormatTime(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("FormatTime(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("FormatTime(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/formatter"
 	"github.com/dheeraj-sn/str2go/model"
 	"github.com/dheeraj-sn/str2go/typeregistry"
 )
@@ -12,6 +13,9 @@ import (
 var once sync.Once
 var globalRegistry *typeregistry.TypeRegistry
 
+var formatOnce sync.Once
+var globalFormatRegistry *typeregistry.FormatRegistry
+
 func init() {
 	getGlobalRegistry()
 }
@@ -27,6 +31,34 @@ func getGlobalRegistry() *typeregistry.TypeRegistry {
 	return globalRegistry
 }
 
+func getGlobalFormatRegistry() *typeregistry.FormatRegistry {
+	formatOnce.Do(func() {
+		globalFormatRegistry = typeregistry.NewFormatRegistry()
+		globalFormatRegistry.RegisterAllFormatters(formatter.GetFormatterMap())
+	})
+
+	return globalFormatRegistry
+}
+
 func GetConverter(targetType reflect.Type) (model.ConverterFunc, bool) {
 	return getGlobalRegistry().Get(targetType)
 }
+
+// Convert converts value to targetType using the global registry
+func Convert(value string, targetType reflect.Type) (interface{}, error) {
+	return getGlobalRegistry().Convert(value, targetType)
+}
+
+func GetFormatter(sourceType reflect.Type) (model.FormatterFunc, bool) {
+	return getGlobalFormatRegistry().GetFormatter(sourceType)
+}
+
+// Format formats value using the global format registry, so that Convert(Format(v)) returns v
+func Format(value interface{}) (string, error) {
+	return getGlobalFormatRegistry().Format(value)
+}
+
+// Infer guesses the narrowest type for value using the global registry and the default candidates
+func Infer(value string) (typeregistry.Inference, error) {
+	return getGlobalRegistry().Infer(value)
+}
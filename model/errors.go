@@ -0,0 +1,29 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ConversionError describes why a string could not be converted to a target type
+type ConversionError struct {
+	// Value is the input that failed to convert
+	Value string
+	// Type is the target type of the conversion
+	Type reflect.Type
+	// Offset is the byte offset in Value where the problem was found, or -1 when unknown
+	Offset int
+	// Err is the underlying cause
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	if e.Offset >= 0 {
+		return fmt.Sprintf("cannot convert %q to %s at offset %d: %v", e.Value, e.Type, e.Offset, e.Err)
+	}
+	return fmt.Sprintf("cannot convert %q to %s: %v", e.Value, e.Type, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
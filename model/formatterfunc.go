@@ -0,0 +1,4 @@
+package model
+
+// FormatterFunc represents a function that formats a value of a specific type as a string
+type FormatterFunc func(value interface{}) (string, error)
@@ -0,0 +1,16 @@
+package model
+
+import "reflect"
+
+// OptionalValue is implemented by pointers to wrapper types, such as *optional.Optional[T],
+// that record whether a value was present. TypeRegistry converts into these types natively,
+// using the converter registered for the wrapped type.
+type OptionalValue interface {
+	// ValueType returns the type of the wrapped value
+	ValueType() reflect.Type
+	// SetNull marks the value as explicitly null
+	SetNull()
+	// SetValue stores v, which must have the type returned by ValueType; implementations
+	// return an error otherwise
+	SetValue(v interface{}) error
+}
@@ -0,0 +1,163 @@
+// Package optional provides Optional[T], a value that records whether it was never set,
+// explicitly null or set to a valid value, which pointers cannot tell apart.
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/dheeraj-sn/str2go/globalregistry"
+)
+
+// State describes whether an Optional holds a value
+type State uint8
+
+const (
+	// Unset means no value was provided, e.g. a missing JSON key; it is the zero State
+	Unset State = iota
+	// Null means a value was provided and was explicitly null
+	Null
+	// Valid means a value was provided and parsed
+	Valid
+)
+
+// Optional holds a T together with its State. The zero value is Unset, so struct fields
+// tagged `json:",omitzero"` are omitted when unset.
+type Optional[T any] struct {
+	value T
+	state State
+}
+
+// Of returns a Valid Optional holding value
+func Of[T any](value T) Optional[T] {
+	return Optional[T]{value: value, state: Valid}
+}
+
+// OfNull returns a Null Optional
+func OfNull[T any]() Optional[T] {
+	return Optional[T]{state: Null}
+}
+
+// State returns whether o is Unset, Null or Valid
+func (o Optional[T]) State() State {
+	return o.state
+}
+
+// IsSet reports whether a value was provided, even if it was null
+func (o Optional[T]) IsSet() bool {
+	return o.state != Unset
+}
+
+// IsNull reports whether o was explicitly null
+func (o Optional[T]) IsNull() bool {
+	return o.state == Null
+}
+
+// IsValid reports whether o holds a value
+func (o Optional[T]) IsValid() bool {
+	return o.state == Valid
+}
+
+// Get returns the value and whether o is Valid
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == Valid
+}
+
+// OrElse returns the value if o is Valid, and fallback otherwise
+func (o Optional[T]) OrElse(fallback T) T {
+	if o.state == Valid {
+		return o.value
+	}
+	return fallback
+}
+
+// ValueType implements model.OptionalValue
+func (o *Optional[T]) ValueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// SetNull implements model.OptionalValue
+func (o *Optional[T]) SetNull() {
+	*o = Optional[T]{state: Null}
+}
+
+// SetValue implements model.OptionalValue. It returns an error and leaves o unchanged when
+// v is not a T, rather than storing a zero value as if it were valid.
+func (o *Optional[T]) SetValue(v interface{}) error {
+	value, ok := v.(T)
+	if !ok && (v != nil || !nilable(o.ValueType())) {
+		return fmt.Errorf("optional: SetValue of %T on Optional[%s]", v, o.ValueType())
+	}
+	*o = Of(value)
+	return nil
+}
+
+// nilable reports whether nil is a value of t
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the global registry's converter for T.
+// Empty text is Null unless T is a string type, matching sql.Null[T] in sqlconv. The global
+// registry has no null tokens, so other tokens such as "NULL" are converted as a T; convert
+// with a registry that has EnableNulls to recognize them.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 && o.ValueType().Kind() != reflect.String {
+		o.SetNull()
+		return nil
+	}
+	result, err := globalregistry.Convert(string(text), reflect.TypeOf(o).Elem())
+	if err != nil {
+		return err
+	}
+	*o = result.(Optional[T])
+	return nil
+}
+
+// MarshalJSON encodes a Valid Optional as its value, and an Unset or Null one as null
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as Null and anything else as a Valid value. JSON strings that
+// do not decode into T directly, such as "42" for an Optional[int], are converted with
+// UnmarshalText. Missing keys never reach UnmarshalJSON, so they stay Unset.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.SetNull()
+		return nil
+	}
+
+	var value T
+	err := json.Unmarshal(data, &value)
+	if err == nil {
+		*o = Of(value)
+		return nil
+	}
+
+	var text string
+	if json.Unmarshal(data, &text) != nil {
+		return err
+	}
+	return o.UnmarshalText([]byte(text))
+}
+
+func (s State) String() string {
+	switch s {
+	case Unset:
+		return "unset"
+	case Null:
+		return "null"
+	case Valid:
+		return "valid"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
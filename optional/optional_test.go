@@ -0,0 +1,183 @@
+package optional
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+func TestStates(t *testing.T) {
+	var unset Optional[int]
+	null := OfNull[int]()
+	valid := Of(0)
+
+	tests := []struct {
+		name     string
+		optional Optional[int]
+		state    State
+		set      bool
+		isNull   bool
+		isValid  bool
+	}{
+		{"unset", unset, Unset, false, false, false},
+		{"null", null, Null, true, true, false},
+		{"valid zero", valid, Valid, true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.optional
+			if o.State() != tt.state || o.IsSet() != tt.set || o.IsNull() != tt.isNull || o.IsValid() != tt.isValid {
+				t.Errorf("%s: State=%s IsSet=%v IsNull=%v IsValid=%v", tt.name, o.State(), o.IsSet(), o.IsNull(), o.IsValid())
+			}
+			if _, ok := o.Get(); ok != tt.isValid {
+				t.Errorf("%s: Get ok = %v, expected %v", tt.name, ok, tt.isValid)
+			}
+		})
+	}
+
+	if Of(5).OrElse(9) != 5 || unset.OrElse(9) != 9 || null.OrElse(9) != 9 {
+		t.Error("OrElse should return the value only when valid")
+	}
+	if State(7).String() != "State(7)" || Valid.String() != "valid" {
+		t.Errorf("State.String = %q, %q", State(7).String(), Valid.String())
+	}
+}
+
+func TestRegistryConvert(t *testing.T) {
+	registry := typeregistry.NewTypeRegistry()
+	// StringToInt8 returns an int64, which is narrowed to the wrapped int8
+	registry.Register(reflect.TypeOf(int8(0)), converter.StringToInt8)
+	registry.EnableNulls(typeregistry.DefaultNullOptions())
+
+	result, err := registry.Convert("42", reflect.TypeOf(Optional[int8]{}))
+	if err != nil {
+		t.Fatalf("Convert(%q) unexpected error: %v", "42", err)
+	}
+	if value, ok := result.(Optional[int8]).Get(); !ok || value != 42 {
+		t.Errorf("Convert(%q) = %+v, expected valid 42", "42", result)
+	}
+
+	result, err = registry.Convert("NULL", reflect.TypeOf(Optional[int8]{}))
+	if err != nil || !result.(Optional[int8]).IsNull() {
+		t.Errorf("Convert(%q) = %+v, %v; expected null", "NULL", result, err)
+	}
+
+	result, err = registry.Convert("7", reflect.TypeOf(&Optional[int8]{}))
+	if err != nil || result.(*Optional[int8]).OrElse(0) != 7 {
+		t.Errorf("Convert(%q, *Optional) = %+v, %v; expected valid 7", "7", result, err)
+	}
+
+	if _, err := registry.Convert("300", reflect.TypeOf(Optional[int8]{})); err == nil {
+		t.Errorf("Convert(%q) expected range error, got nil", "300")
+	}
+	if _, exists := registry.Get(reflect.TypeOf(Optional[bool]{})); exists {
+		t.Error("Optional[bool] should not convert without a bool converter")
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	var n Optional[int]
+	if err := n.SetValue(5); err != nil {
+		t.Fatalf("SetValue(5) unexpected error: %v", err)
+	}
+	if value, ok := n.Get(); !ok || value != 5 {
+		t.Errorf("SetValue(5) = %+v, expected valid 5", n)
+	}
+
+	var p Optional[*int]
+	if err := p.SetValue(nil); err != nil {
+		t.Fatalf("SetValue(nil) unexpected error: %v", err)
+	}
+	if value, ok := p.Get(); !ok || value != nil {
+		t.Errorf("SetValue(nil) = %+v, expected a valid nil pointer", p)
+	}
+
+	for _, v := range []interface{}{"5", int64(5), nil} {
+		wrong := OfNull[int]()
+		if err := wrong.SetValue(v); err == nil {
+			t.Errorf("SetValue(%#v) on Optional[int] expected error, got nil", v)
+		}
+		if !wrong.IsNull() {
+			t.Errorf("SetValue(%#v) on Optional[int] = %+v, expected it unchanged", v, wrong)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var o Optional[chan int]
+	if err := o.UnmarshalText([]byte("oops")); err == nil {
+		t.Error("UnmarshalText without a converter for the wrapped type should fail")
+	}
+
+	var n Optional[int]
+	if err := n.UnmarshalText([]byte("12")); err != nil {
+		t.Fatalf("UnmarshalText unexpected error: %v", err)
+	}
+	if value, ok := n.Get(); !ok || value != 12 {
+		t.Errorf("UnmarshalText(%q) = %+v, expected valid 12", "12", n)
+	}
+
+	if err := n.UnmarshalText(nil); err != nil || !n.IsNull() {
+		t.Errorf("UnmarshalText(%q) = %+v, %v; expected null", "", n, err)
+	}
+	var s Optional[string]
+	if err := s.UnmarshalText(nil); err != nil || !s.IsValid() {
+		t.Errorf("UnmarshalText(%q) into Optional[string] = %+v, %v; expected a valid empty string", "", s, err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type config struct {
+		Port    Optional[int]    `json:"port"`
+		Host    Optional[string] `json:"host"`
+		Retries Optional[int]    `json:"retries"`
+		Debug   Optional[bool]   `json:"debug,omitzero"`
+	}
+
+	var c config
+	if err := json.Unmarshal([]byte(`{"port": "8080", "host": null, "retries": 3}`), &c); err != nil {
+		t.Fatalf("json.Unmarshal unexpected error: %v", err)
+	}
+	if port, ok := c.Port.Get(); !ok || port != 8080 {
+		t.Errorf("Port = %+v, expected valid 8080", c.Port)
+	}
+	if !c.Host.IsNull() {
+		t.Errorf("Host = %+v, expected null", c.Host)
+	}
+	if retries, ok := c.Retries.Get(); !ok || retries != 3 {
+		t.Errorf("Retries = %+v, expected valid 3", c.Retries)
+	}
+	if c.Debug.IsSet() {
+		t.Errorf("Debug = %+v, expected unset for a missing key", c.Debug)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("json.Marshal unexpected error: %v", err)
+	}
+	if expected := `{"port":8080,"host":null,"retries":3}`; string(data) != expected {
+		t.Errorf("json.Marshal = %s, expected %s", data, expected)
+	}
+
+	var blank config
+	if err := json.Unmarshal([]byte(`{"port": ""}`), &blank); err != nil || !blank.Port.IsNull() {
+		t.Errorf("Port from an empty string = %+v, %v; expected null", blank.Port, err)
+	}
+
+	var empty Optional[string]
+	if err := json.Unmarshal([]byte(`""`), &empty); err != nil || !empty.IsValid() {
+		t.Errorf("empty string = %+v, %v; expected a valid empty string", empty, err)
+	}
+
+	var bad Optional[int]
+	if err := json.Unmarshal([]byte(`"abc"`), &bad); err == nil {
+		t.Error("json.Unmarshal of a non-numeric string into Optional[int] should fail")
+	}
+	if err := json.Unmarshal([]byte(`[1]`), &bad); err == nil {
+		t.Error("json.Unmarshal of an array into Optional[int] should fail")
+	}
+}
@@ -0,0 +1,142 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// initialisms are written in capitals in field names, as in Go's own naming conventions
+var initialisms = map[string]bool{
+	"API": true, "CSV": true, "DNS": true, "HTML": true, "HTTP": true, "ID": true, "IP": true,
+	"JSON": true, "SQL": true, "TCP": true, "UDP": true, "URI": true, "URL": true, "UTC": true,
+	"UUID": true, "XML": true,
+}
+
+// GoSource returns gofmt-formatted source for a package declaring a struct named typeName
+// with one field per column. Fields are exported names derived from the headers, nullable
+// columns become pointers, and each field has a csv tag with the header and, for time
+// columns, a layout tag with the detected layout.
+func (s Schema) GoSource(packageName, typeName string) ([]byte, error) {
+	if !token.IsIdentifier(packageName) {
+		return nil, fmt.Errorf("invalid package name: %q", packageName)
+	}
+	if !token.IsIdentifier(typeName) || !token.IsExported(typeName) {
+		return nil, fmt.Errorf("invalid exported type name: %q", typeName)
+	}
+
+	imports := map[string]bool{}
+	used := map[string]int{}
+	var fields bytes.Buffer
+	for i, column := range s.Columns {
+		fieldType := column.Type
+		if column.Nullable && !isNilable(fieldType) {
+			fieldType = reflect.PointerTo(fieldType)
+		}
+		if path := importPath(fieldType); path != "" {
+			imports[path] = true
+		}
+
+		tag := fmt.Sprintf("csv:%q", column.Name)
+		if column.TimeLayout != "" {
+			tag += fmt.Sprintf(" layout:%q", column.TimeLayout)
+		}
+		fmt.Fprintf(&fields, "\t%s %s %s\n", uniqueName(fieldName(column.Name, i), used), fieldType, quoteTag(tag))
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "package %s\n\n", packageName)
+	if len(imports) > 0 {
+		paths := make([]string, 0, len(imports))
+		for path := range imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		src.WriteString("import (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+		src.WriteString(")\n\n")
+	}
+	fmt.Fprintf(&src, "// %s is a row of the inferred schema\ntype %s struct {\n", typeName, typeName)
+	src.Write(fields.Bytes())
+	src.WriteString("}\n")
+
+	return format.Source(src.Bytes())
+}
+
+// quoteTag writes a struct tag as a raw string unless it contains a backquote
+func quoteTag(tag string) string {
+	if strconv.CanBackquote(tag) {
+		return "`" + tag + "`"
+	}
+	return strconv.Quote(tag)
+}
+
+func isNilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// importPath returns the package t, or the type it points to, is declared in
+func importPath(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t.PkgPath()
+}
+
+// fieldName turns a header such as "order id" or "created-at" into an exported Go name such as
+// OrderID or CreatedAt; headers without letters or digits become Column1, Column2 and so on
+func fieldName(header string, index int) string {
+	words := strings.FieldsFunc(header, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var name strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			name.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		name.WriteRune(unicode.ToUpper(runes[0]))
+		name.WriteString(string(runes[1:]))
+	}
+
+	result := name.String()
+	switch {
+	case result == "":
+		return fmt.Sprintf("Column%d", index+1)
+	case !unicode.IsLetter([]rune(result)[0]):
+		return "Column" + result
+	case !token.IsExported(result):
+		// Letters without case, such as in CJK headers, cannot start an exported name
+		return "X" + result
+	}
+	return result
+}
+
+// uniqueName appends a number to names already used by earlier fields
+func uniqueName(name string, used map[string]int) string {
+	used[name]++
+	if used[name] == 1 {
+		return name
+	}
+	for {
+		candidate := fmt.Sprintf("%s%d", name, used[name])
+		if used[candidate] == 0 {
+			used[candidate] = 1
+			return candidate
+		}
+		used[name]++
+	}
+}
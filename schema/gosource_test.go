@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+)
+
+// TestGoSource tests the struct source generated for an inferred schema
+func TestGoSource(t *testing.T) {
+	input := "order id,price,created,ship by,timeout,note,Note,12,\n" +
+		"1,9.99,2024-02-29,13/01/2024,1h,hello,,x,a\n" +
+		"2,,2024-03-01,02/01/2024,30s,NA,y,1,b\n"
+	s, err := InferCSV(strings.NewReader(input), Options{})
+	if err != nil {
+		t.Fatalf("InferCSV unexpected error: %v", err)
+	}
+
+	src, err := s.GoSource("feed", "Row")
+	if err != nil {
+		t.Fatalf("GoSource unexpected error: %v", err)
+	}
+	expected := "package feed\n\n" +
+		"import (\n\t\"time\"\n)\n\n" +
+		"// Row is a row of the inferred schema\n" +
+		"type Row struct {\n" +
+		"\tOrderID  int8          `csv:\"order id\"`\n" +
+		"\tPrice    *float64      `csv:\"price\"`\n" +
+		"\tCreated  time.Time     `csv:\"created\" layout:\"2006-01-02\"`\n" +
+		"\tShipBy   time.Time     `csv:\"ship by\" layout:\"02/01/2006\"`\n" +
+		"\tTimeout  time.Duration `csv:\"timeout\"`\n" +
+		"\tNote     *string       `csv:\"note\"`\n" +
+		"\tNote2    *string       `csv:\"Note\"`\n" +
+		"\tColumn12 string        `csv:\"12\"`\n" +
+		"\tColumn9  string        `csv:\"\"`\n" +
+		"}\n"
+	if string(src) != expected {
+		t.Errorf("GoSource() =\n%s\nexpected\n%s", src, expected)
+	}
+}
+
+// TestGoSourceImports tests that types from other packages are imported once, in order
+func TestGoSourceImports(t *testing.T) {
+	s := Schema{Columns: []Column{
+		{Name: "share", Type: reflect.TypeOf(converter.Percent(0))},
+		{Name: "at", Type: timeType, Nullable: true},
+		{Name: "until", Type: timeType},
+		{Name: "count", Type: int64Type},
+	}}
+	src, err := s.GoSource("feed", "Row")
+	if err != nil {
+		t.Fatalf("GoSource unexpected error: %v", err)
+	}
+	for _, expected := range []string{
+		"import (\n\t\"github.com/dheeraj-sn/str2go/converter\"\n\t\"time\"\n)",
+		"Share converter.Percent",
+		"At    *time.Time",
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("GoSource() =\n%s\nexpected it to contain %q", src, expected)
+		}
+	}
+
+	// Without imported types there is no import block
+	src, err = Schema{Columns: []Column{{Name: "n", Type: int64Type}}}.GoSource("feed", "Row")
+	if err != nil {
+		t.Fatalf("GoSource unexpected error: %v", err)
+	}
+	if strings.Contains(string(src), "import") {
+		t.Errorf("GoSource() =\n%s\nexpected no imports", src)
+	}
+}
+
+// TestGoSourceErrors tests invalid package and type names
+func TestGoSourceErrors(t *testing.T) {
+	tests := []struct {
+		packageName string
+		typeName    string
+	}{
+		{"", "Row"},
+		{"my-feed", "Row"},
+		{"feed", "row"},
+		{"feed", "Row Type"},
+		{"feed", ""},
+	}
+
+	for _, tt := range tests {
+		if _, err := (Schema{}).GoSource(tt.packageName, tt.typeName); err == nil {
+			t.Errorf("GoSource(%q, %q) expected error, got nil", tt.packageName, tt.typeName)
+		}
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"name", "Name"},
+		{"order id", "OrderID"},
+		{"created-at", "CreatedAt"},
+		{"user_url", "UserURL"},
+		{"HTTPStatus", "HTTPStatus"},
+		{"2nd place", "Column2ndPlace"},
+		{"  ", "Column4"},
+		{"名前", "X名前"},
+		{"émission", "Émission"},
+	}
+
+	for _, tt := range tests {
+		if result := fieldName(tt.header, 3); result != tt.expected {
+			t.Errorf("fieldName(%q) = %q, expected %q", tt.header, result, tt.expected)
+		}
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	used := map[string]int{}
+	var result []string
+	for _, name := range []string{"Note", "Note", "Note2", "Note"} {
+		result = append(result, uniqueName(name, used))
+	}
+	expected := []string{"Note", "Note2", "Note22", "Note3"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("uniqueName = %v, expected %v", result, expected)
+	}
+}
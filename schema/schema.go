@@ -0,0 +1,299 @@
+// Package schema infers column types for tabular data, such as a new CSV feed, from sample
+// values, and emits the result as Go struct source for bootstrapping importers.
+package schema
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// Options configures schema inference
+type Options struct {
+	// Candidates are the column types tried, narrowest first; a column gets the first that
+	// every non-null sample converts to, and string when none does. DefaultCandidates by default.
+	Candidates []reflect.Type
+	// TimeLayouts are the layouts tried for time.Time columns, in order; a column is time.Time
+	// only when one layout parses every sample. DefaultTimeLayouts by default.
+	TimeLayouts []string
+	// Nulls are the tokens that mark missing values; typeregistry.DefaultNullOptions by
+	// default, and an empty NullOptions disables null detection
+	Nulls *typeregistry.NullOptions
+	// SampleRows limits the rows InferCSV reads after the header; zero reads every row
+	SampleRows int
+}
+
+// DefaultCandidates returns bool, int8, int16, int32, int64, float64, time.Time,
+// time.Duration and string, so integer columns widen to float64 before falling back to string
+func DefaultCandidates() []reflect.Type {
+	return []reflect.Type{
+		reflect.TypeOf(false),
+		reflect.TypeOf(int8(0)),
+		reflect.TypeOf(int16(0)),
+		reflect.TypeOf(int32(0)),
+		reflect.TypeOf(int64(0)),
+		reflect.TypeOf(float64(0)),
+		timeType,
+		reflect.TypeOf(time.Duration(0)),
+		stringType,
+	}
+}
+
+// DefaultTimeLayouts returns ISO 8601 dates and times, the layouts accepted by
+// converter.StringToTime, and US then European slash-separated dates
+func DefaultTimeLayouts() []string {
+	return []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+		time.RFC1123Z,
+		time.RFC1123,
+		time.RFC822Z,
+		time.RFC822,
+		time.RFC850,
+		time.ANSIC,
+		"01/02/2006",
+		"02/01/2006",
+		"2006/01/02",
+	}
+}
+
+// Column is the inferred type of one column
+type Column struct {
+	// Name is the column header
+	Name string
+	// Type is the narrowest candidate every non-null sample converts to
+	Type reflect.Type
+	// Alternatives are the wider candidates every non-null sample also converts to, such as
+	// int64 for a column of 0 and 1 inferred as bool
+	Alternatives []reflect.Type
+	// TimeLayout is the layout that parses every sample of a time.Time column
+	TimeLayout string
+	// Nullable reports that some samples were null tokens
+	Nullable bool
+	// Samples and Nulls count the non-null and null samples
+	Samples, Nulls int
+}
+
+// Schema is the inferred type of every column, in header order
+type Schema struct {
+	Columns []Column
+}
+
+// Inferrer accumulates rows and infers the narrowest type of each column
+type Inferrer struct {
+	registry   *typeregistry.TypeRegistry
+	candidates []reflect.Type
+	layouts    []string
+	columns    []columnState
+}
+
+// columnState records which candidates and layouts accept every sample seen so far
+type columnState struct {
+	name     string
+	accepted []bool
+	layouts  []bool
+	samples  int
+	nulls    int
+}
+
+// NewInferrer returns an Inferrer for columns named by header
+func NewInferrer(header []string, opts Options) (*Inferrer, error) {
+	candidates := opts.Candidates
+	if candidates == nil {
+		candidates = DefaultCandidates()
+	}
+	layouts := opts.TimeLayouts
+	if layouts == nil {
+		layouts = DefaultTimeLayouts()
+	}
+	nulls := typeregistry.DefaultNullOptions()
+	if opts.Nulls != nil {
+		nulls = *opts.Nulls
+	}
+
+	registry := typeregistry.NewTypeRegistry()
+	registry.RegisterAll(converter.GetConvertorMap())
+	registry.EnableNulls(nulls)
+
+	// time.Time columns are decided by layout, so every other candidate goes to Infer
+	var inferCandidates []reflect.Type
+	for _, candidate := range candidates {
+		if candidate == timeType {
+			continue
+		}
+		if _, exists := registry.Get(candidate); !exists {
+			return nil, fmt.Errorf("no converter registered for candidate type: %s", candidate)
+		}
+		inferCandidates = append(inferCandidates, candidate)
+	}
+	registry.SetInferOptions(typeregistry.InferOptions{Candidates: inferCandidates})
+
+	in := &Inferrer{registry: registry, candidates: candidates, layouts: layouts}
+	for _, name := range header {
+		in.columns = append(in.columns, columnState{
+			name:     name,
+			accepted: allTrue(len(candidates)),
+			layouts:  allTrue(len(layouts)),
+		})
+	}
+	return in, nil
+}
+
+func allTrue(n int) []bool {
+	values := make([]bool, n)
+	for i := range values {
+		values[i] = true
+	}
+	return values
+}
+
+// Add records one row of samples, which must have a value for every column
+func (in *Inferrer) Add(row []string) error {
+	if len(row) != len(in.columns) {
+		return fmt.Errorf("row has %d fields, expected %d", len(row), len(in.columns))
+	}
+	for i, value := range row {
+		if err := in.columns[i].add(in, value); err != nil {
+			return fmt.Errorf("column %q: %w", in.columns[i].name, err)
+		}
+	}
+	return nil
+}
+
+func (c *columnState) add(in *Inferrer, value string) error {
+	inference, err := in.registry.Infer(value)
+	if err != nil {
+		// No candidate accepts the value, which only happens without a string candidate
+		inference = typeregistry.Inference{}
+	}
+	if inference.Null {
+		c.nulls++
+		return nil
+	}
+	c.samples++
+
+	parsedAny := false
+	for j, layout := range in.layouts {
+		if c.layouts[j] {
+			_, err := time.Parse(layout, value)
+			c.layouts[j] = err == nil
+			parsedAny = parsedAny || err == nil
+		}
+	}
+	for i, candidate := range in.candidates {
+		if !c.accepted[i] {
+			continue
+		}
+		if candidate == timeType {
+			c.accepted[i] = parsedAny
+		} else {
+			c.accepted[i] = containsType(inference.Matches, candidate)
+		}
+	}
+	return nil
+}
+
+func containsType(types []reflect.Type, t reflect.Type) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Schema returns the types inferred from the rows added so far. Columns without non-null
+// samples are strings.
+func (in *Inferrer) Schema() Schema {
+	var s Schema
+	for _, state := range in.columns {
+		column := Column{
+			Name:     state.name,
+			Nullable: state.nulls > 0,
+			Samples:  state.samples,
+			Nulls:    state.nulls,
+		}
+		if state.samples > 0 {
+			for i, candidate := range in.candidates {
+				if !state.accepted[i] {
+					continue
+				}
+				if column.Type == nil {
+					column.Type = candidate
+				} else {
+					column.Alternatives = append(column.Alternatives, candidate)
+				}
+			}
+		}
+		if column.Type == nil {
+			column.Type = stringType
+		}
+		if column.Type == timeType {
+			column.TimeLayout = in.layouts[firstTrue(state.layouts)]
+		}
+		s.Columns = append(s.Columns, column)
+	}
+	return s
+}
+
+func firstTrue(values []bool) int {
+	for i, value := range values {
+		if value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Infer infers a schema from rows of samples for the columns named by header
+func Infer(header []string, rows [][]string, opts Options) (Schema, error) {
+	in, err := NewInferrer(header, opts)
+	if err != nil {
+		return Schema{}, err
+	}
+	for i, row := range rows {
+		if err := in.Add(row); err != nil {
+			return Schema{}, fmt.Errorf("row %d: %w", i+1, err)
+		}
+	}
+	return in.Schema(), nil
+}
+
+// InferCSV reads a header and up to opts.SampleRows rows of CSV from r and infers their schema
+func InferCSV(r io.Reader, opts Options) (Schema, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return Schema{}, fmt.Errorf("reading CSV header: %w", err)
+	}
+	in, err := NewInferrer(header, opts)
+	if err != nil {
+		return Schema{}, err
+	}
+	for rows := 0; opts.SampleRows == 0 || rows < opts.SampleRows; rows++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Schema{}, fmt.Errorf("reading CSV: %w", err)
+		}
+		if err := in.Add(row); err != nil {
+			return Schema{}, fmt.Errorf("row %d: %w", rows+1, err)
+		}
+	}
+	return in.Schema(), nil
+}
@@ -0,0 +1,241 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+var (
+	boolType     = reflect.TypeOf(false)
+	int8Type     = reflect.TypeOf(int8(0))
+	int16Type    = reflect.TypeOf(int16(0))
+	int64Type    = reflect.TypeOf(int64(0))
+	float64Type  = reflect.TypeOf(float64(0))
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// TestInferWidening tests that each column gets the narrowest type every sample converts to
+func TestInferWidening(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []string
+		expected reflect.Type
+	}{
+		{"bool", []string{"true", "false", "0"}, boolType},
+		{"int8", []string{"1", "2", "-128"}, int8Type},
+		{"int16", []string{"1", "300"}, int16Type},
+		{"int64", []string{"1", "9223372036854775807"}, int64Type},
+		{"float64", []string{"1", "2.5", "1e3"}, float64Type},
+		{"duration", []string{"1h", "30s"}, durationType},
+		{"time", []string{"2024-02-29", "2024-03-01"}, timeType},
+		{"string", []string{"1", "one"}, stringType},
+		{"string after time", []string{"2024-02-29", "1h"}, stringType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := make([][]string, len(tt.samples))
+			for i, sample := range tt.samples {
+				rows[i] = []string{sample}
+			}
+			s, err := Infer([]string{tt.name}, rows, Options{})
+			if err != nil {
+				t.Fatalf("Infer(%q) unexpected error: %v", tt.samples, err)
+			}
+			if result := s.Columns[0].Type; result != tt.expected {
+				t.Errorf("Infer(%q) type = %s, expected %s", tt.samples, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestInferAlternatives tests that wider candidates accepting every sample are listed in order
+func TestInferAlternatives(t *testing.T) {
+	s, err := Infer([]string{"flag"}, [][]string{{"0"}, {"1"}}, Options{})
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	column := s.Columns[0]
+	expected := []reflect.Type{int8Type, int16Type, reflect.TypeOf(int32(0)), int64Type, float64Type, stringType}
+	if column.Type != boolType || !reflect.DeepEqual(column.Alternatives, expected) {
+		t.Errorf("Infer column = %s %v, expected bool %v", column.Type, column.Alternatives, expected)
+	}
+}
+
+// TestInferNullable tests that null tokens mark columns nullable without affecting their type
+func TestInferNullable(t *testing.T) {
+	header := []string{"price", "missing", "plain"}
+	rows := [][]string{
+		{"9.99", "", "a"},
+		{"NULL", "n/a", "b"},
+		{"", "NA", "c"},
+	}
+	s, err := Infer(header, rows, Options{})
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+
+	expected := []Column{
+		{Name: "price", Type: float64Type, Alternatives: []reflect.Type{stringType}, Nullable: true, Samples: 1, Nulls: 2},
+		{Name: "missing", Type: stringType, Nullable: true, Nulls: 3},
+		{Name: "plain", Type: stringType, Samples: 3},
+	}
+	if !reflect.DeepEqual(s.Columns, expected) {
+		t.Errorf("Infer columns = %+v, expected %+v", s.Columns, expected)
+	}
+
+	// Without null tokens empty values are strings
+	s, err = Infer([]string{"price"}, [][]string{{"9.99"}, {""}}, Options{Nulls: &typeregistry.NullOptions{}})
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if column := s.Columns[0]; column.Type != stringType || column.Nullable {
+		t.Errorf("Infer without nulls = %+v, expected a non-nullable string column", column)
+	}
+}
+
+// TestInferTimeLayouts tests that the first layout parsing every sample is chosen
+func TestInferTimeLayouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []string
+		expected string
+	}{
+		{"RFC 3339", []string{"2024-02-29T10:00:00Z", "2024-03-01T12:30:00+02:00"}, time.RFC3339},
+		{"ISO date", []string{"2024-02-29"}, "2006-01-02"},
+		{"space separated", []string{"2024-02-29 10:00:00"}, "2006-01-02 15:04:05"},
+		{"US date", []string{"01/02/2024", "12/31/2024"}, "01/02/2006"},
+		{"European date", []string{"01/02/2024", "31/12/2024"}, "02/01/2006"},
+		{"RFC 1123", []string{"Thu, 29 Feb 2024 10:00:00 GMT"}, time.RFC1123},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := make([][]string, len(tt.samples))
+			for i, sample := range tt.samples {
+				rows[i] = []string{sample}
+			}
+			s, err := Infer([]string{"at"}, rows, Options{})
+			if err != nil {
+				t.Fatalf("Infer(%q) unexpected error: %v", tt.samples, err)
+			}
+			column := s.Columns[0]
+			if column.Type != timeType || column.TimeLayout != tt.expected {
+				t.Errorf("Infer(%q) = %s %q, expected time.Time %q", tt.samples, column.Type, column.TimeLayout, tt.expected)
+			}
+		})
+	}
+
+	// Samples no single layout parses are not times
+	s, err := Infer([]string{"at"}, [][]string{{"2024-02-29"}, {"02/29/2024"}}, Options{})
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if column := s.Columns[0]; column.Type != stringType || column.TimeLayout != "" {
+		t.Errorf("Infer mixed layouts = %s %q, expected string", column.Type, column.TimeLayout)
+	}
+}
+
+// TestInferCustomOptions tests custom candidates and layouts
+func TestInferCustomOptions(t *testing.T) {
+	opts := Options{
+		Candidates:  []reflect.Type{int64Type, timeType, stringType},
+		TimeLayouts: []string{"2006.01.02"},
+	}
+	s, err := Infer([]string{"n", "day", "iso"}, [][]string{{"1", "2024.02.29", "2024-02-29"}}, opts)
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	expected := []reflect.Type{int64Type, timeType, stringType}
+	for i, column := range s.Columns {
+		if column.Type != expected[i] {
+			t.Errorf("Infer column %q = %s, expected %s", column.Name, column.Type, expected[i])
+		}
+	}
+
+	// Without a string candidate unconvertible columns still fall back to string
+	s, err = Infer([]string{"n"}, [][]string{{"1"}, {"x"}}, Options{Candidates: []reflect.Type{int64Type}})
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if column := s.Columns[0]; column.Type != stringType || len(column.Alternatives) != 0 {
+		t.Errorf("Infer without string candidate = %s %v, expected string", column.Type, column.Alternatives)
+	}
+}
+
+// TestInferErrors tests invalid candidates and rows of the wrong width
+func TestInferErrors(t *testing.T) {
+	if _, err := NewInferrer([]string{"a"}, Options{Candidates: []reflect.Type{reflect.TypeOf(struct{}{})}}); err == nil {
+		t.Error("NewInferrer expected error for a candidate without a converter, got nil")
+	}
+	if _, err := Infer([]string{"a", "b"}, [][]string{{"1", "2"}, {"3"}}, Options{}); err == nil {
+		t.Error("Infer expected error for a short row, got nil")
+	}
+	if _, err := InferCSV(strings.NewReader(""), Options{}); err == nil {
+		t.Error("InferCSV expected error for a missing header, got nil")
+	}
+	if _, err := InferCSV(strings.NewReader("a,b\n1,2\n3\n"), Options{}); err == nil {
+		t.Error("InferCSV expected error for a short record, got nil")
+	}
+}
+
+// TestInferCSV tests reading a header and sample rows from CSV
+func TestInferCSV(t *testing.T) {
+	input := "id,amount,paid\n1,10,true\n2,10.5,false\n3,oops,maybe\n"
+
+	s, err := InferCSV(strings.NewReader(input), Options{})
+	if err != nil {
+		t.Fatalf("InferCSV unexpected error: %v", err)
+	}
+	expected := []reflect.Type{int8Type, stringType, stringType}
+	for i, column := range s.Columns {
+		if column.Type != expected[i] || column.Samples != 3 {
+			t.Errorf("InferCSV column %q = %s from %d samples, expected %s from 3", column.Name, column.Type, column.Samples, expected[i])
+		}
+	}
+
+	// SampleRows stops before the row that widens amount and paid to string
+	s, err = InferCSV(strings.NewReader(input), Options{SampleRows: 2})
+	if err != nil {
+		t.Fatalf("InferCSV unexpected error: %v", err)
+	}
+	expected = []reflect.Type{int8Type, float64Type, boolType}
+	for i, column := range s.Columns {
+		if column.Type != expected[i] || column.Samples != 2 {
+			t.Errorf("InferCSV column %q = %s from %d samples, expected %s from 2", column.Name, column.Type, column.Samples, expected[i])
+		}
+	}
+}
+
+// TestInferrerIncremental tests that the schema reflects the rows added so far
+func TestInferrerIncremental(t *testing.T) {
+	in, err := NewInferrer([]string{"n"}, Options{})
+	if err != nil {
+		t.Fatalf("NewInferrer unexpected error: %v", err)
+	}
+	if column := in.Schema().Columns[0]; column.Type != stringType || column.Samples != 0 {
+		t.Errorf("Schema without rows = %+v, expected a string column", column)
+	}
+
+	for _, step := range []struct {
+		value    string
+		expected reflect.Type
+	}{
+		{"7", int8Type},
+		{"1000", int16Type},
+		{"0.5", float64Type},
+		{"seven", stringType},
+		{"8", stringType},
+	} {
+		if err := in.Add([]string{step.value}); err != nil {
+			t.Fatalf("Add(%q) unexpected error: %v", step.value, err)
+		}
+		if result := in.Schema().Columns[0].Type; result != step.expected {
+			t.Errorf("Schema after %q = %s, expected %s", step.value, result, step.expected)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Package sqlconv connects database/sql to a TypeRegistry: a sql.Scanner that converts
+// string and []byte column values into any registered type, and converters for sql.Null[T].
+package sqlconv
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/model"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+// Scanner implements sql.Scanner by converting column values with a TypeRegistry
+type Scanner struct {
+	registry *typeregistry.TypeRegistry
+	dest     reflect.Value
+}
+
+// NewScanner returns a Scanner that stores converted column values in dest, which must be a
+// non-nil pointer to a type the registry can convert to:
+//
+//	var port uint16
+//	err := row.Scan(sqlconv.NewScanner(registry, &port))
+func NewScanner(registry *typeregistry.TypeRegistry, dest interface{}) *Scanner {
+	return &Scanner{registry: registry, dest: reflect.ValueOf(dest)}
+}
+
+// Scan implements sql.Scanner. Driver values assignable to the destination, including []byte
+// for []byte destinations, are stored directly, copying []byte since drivers may reuse it;
+// other strings and []byte are converted with the registry, as are other driver values after
+// formatting them as text. A NULL column stores nil in pointer, slice, map and interface
+// destinations, marks optional wrappers as null, stores the zero value of sql.Scanner types
+// such as sql.NullInt64, and otherwise fails with typeregistry.ErrNullNotAllowed.
+func (s *Scanner) Scan(src any) error {
+	if !s.dest.IsValid() {
+		return errors.New("sqlconv: destination must be a non-nil pointer, got nil")
+	}
+	if s.dest.Kind() != reflect.Pointer || s.dest.IsNil() {
+		return fmt.Errorf("sqlconv: destination must be a non-nil pointer, got %s", s.dest.Type())
+	}
+	target := s.dest.Elem()
+	if src == nil {
+		return scanNull(target)
+	}
+	if value := reflect.ValueOf(src); value.Type().AssignableTo(target.Type()) {
+		if b, ok := src.([]byte); ok {
+			value = reflect.ValueOf(bytes.Clone(b))
+		}
+		target.Set(value)
+		return nil
+	}
+
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	case time.Time:
+		text = v.Format(time.RFC3339Nano)
+	default:
+		text = fmt.Sprint(v)
+	}
+	return s.registry.ConvertInto(text, s.dest.Interface())
+}
+
+// scanNull stores a NULL column in target
+func scanNull(target reflect.Value) error {
+	if optional, ok := target.Addr().Interface().(model.OptionalValue); ok {
+		optional.SetNull()
+		return nil
+	}
+	switch target.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	if _, ok := target.Addr().Interface().(sql.Scanner); ok {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	return &model.ConversionError{Value: "NULL", Type: target.Type(), Offset: -1, Err: typeregistry.ErrNullNotAllowed}
+}
+
+// RegisterNull registers a converter for sql.Null[T] built from the registry's converter for T.
+// The empty string is NULL unless T is a string type, matching the default sql.Null* converters.
+func RegisterNull[T any](registry *typeregistry.TypeRegistry) error {
+	valueType := reflect.TypeOf((*T)(nil)).Elem()
+	if _, ok := registry.Get(valueType); !ok {
+		return fmt.Errorf("sqlconv: no converter registered for type: %s", valueType)
+	}
+
+	registry.Register(reflect.TypeOf(sql.Null[T]{}), func(value string) (interface{}, error) {
+		if value == "" && valueType.Kind() != reflect.String {
+			return sql.Null[T]{}, nil
+		}
+		var null sql.Null[T]
+		if err := registry.ConvertInto(value, &null.V); err != nil {
+			return nil, err
+		}
+		null.Valid = true
+		return null, nil
+	})
+	return nil
+}
@@ -0,0 +1,238 @@
+package sqlconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/optional"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+// fakeRow is the single row returned by every query to the fake driver
+var fakeRow = []driver.Value{
+	[]byte("8080"),
+	"2024-03-01 10:30:00",
+	int64(42),
+	nil,
+	"0.25",
+}
+
+var fakeColumns = []string{"port", "created", "count", "missing", "ratio"}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("transactions are not supported") }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return 0 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{}, nil }
+
+type fakeRows struct {
+	done bool
+}
+
+func (r *fakeRows) Columns() []string { return fakeColumns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, fakeRow)
+	return nil
+}
+
+func init() {
+	sql.Register("sqlconv-fake", fakeDriver{})
+}
+
+func newTestRegistry() *typeregistry.TypeRegistry {
+	registry := typeregistry.NewTypeRegistry()
+	registry.RegisterAll(converter.GetConvertorMap())
+	return registry
+}
+
+func TestScannerWithFakeDriver(t *testing.T) {
+	db, err := sql.Open("sqlconv-fake", "")
+	if err != nil {
+		t.Fatalf("sql.Open unexpected error: %v", err)
+	}
+	defer db.Close()
+	registry := newTestRegistry()
+
+	var (
+		port     uint16
+		created  time.Time
+		count    int8
+		missing  *int
+		ratio    converter.Percent
+		note     sql.NullString
+		optInt   optional.Optional[int]
+		required int
+	)
+
+	err = db.QueryRow("SELECT *").Scan(
+		NewScanner(registry, &port),
+		NewScanner(registry, &created),
+		NewScanner(registry, &count),
+		NewScanner(registry, &missing),
+		NewScanner(registry, &ratio),
+	)
+	if err != nil {
+		t.Fatalf("Scan unexpected error: %v", err)
+	}
+	if port != 8080 {
+		t.Errorf("port = %d, expected 8080", port)
+	}
+	if expected := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC); !created.Equal(expected) {
+		t.Errorf("created = %v, expected %v", created, expected)
+	}
+	if count != 42 {
+		t.Errorf("count = %d, expected 42", count)
+	}
+	if missing != nil {
+		t.Errorf("missing = %v, expected nil", missing)
+	}
+	if ratio != 0.25 {
+		t.Errorf("ratio = %v, expected 0.25", ratio)
+	}
+
+	// NULL columns fill nullable destinations and reject plain values
+	var ignore any
+	err = db.QueryRow("SELECT *").Scan(&ignore, &ignore, &ignore, NewScanner(registry, &note), &ignore)
+	if err != nil || note.Valid {
+		t.Errorf("NULL into sql.NullString = %v, %v; expected an invalid NullString", note, err)
+	}
+	err = db.QueryRow("SELECT *").Scan(&ignore, &ignore, &ignore, NewScanner(registry, &optInt), &ignore)
+	if err != nil || !optInt.IsNull() {
+		t.Errorf("NULL into Optional[int] = %+v, %v; expected null", optInt, err)
+	}
+	err = db.QueryRow("SELECT *").Scan(&ignore, &ignore, &ignore, NewScanner(registry, &required), &ignore)
+	if !errors.Is(err, typeregistry.ErrNullNotAllowed) {
+		t.Errorf("NULL into int error = %v, expected ErrNullNotAllowed", err)
+	}
+
+	// Conversion errors are reported by Scan
+	var wrong bool
+	err = db.QueryRow("SELECT *").Scan(NewScanner(registry, &wrong), &ignore, &ignore, &ignore, &ignore)
+	if err == nil {
+		t.Error("Scan of \"8080\" into bool expected error, got nil")
+	}
+}
+
+func TestScannerDirectValues(t *testing.T) {
+	registry := newTestRegistry()
+
+	var count int64
+	if err := NewScanner(registry, &count).Scan(int64(7)); err != nil || count != 7 {
+		t.Errorf("Scan(int64(7)) = %d, %v; expected 7", count, err)
+	}
+
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var created time.Time
+	if err := NewScanner(registry, &created).Scan(now); err != nil || !created.Equal(now) {
+		t.Errorf("Scan(time) = %v, %v; expected %v", created, err, now)
+	}
+	var createdText string
+	if err := NewScanner(registry, &createdText).Scan(now); err != nil || createdText != "2024-03-01T00:00:00Z" {
+		t.Errorf("Scan(time) into string = %q, %v", createdText, err)
+	}
+
+	// []byte is stored as is, not decoded by the []byte converter, and copied from the driver
+	column := []byte("hex:00ff")
+	var raw []byte
+	if err := NewScanner(registry, &raw).Scan(column); err != nil || string(raw) != "hex:00ff" {
+		t.Errorf("Scan(%q) into []byte = %q, %v; expected the bytes unchanged", column, raw, err)
+	}
+	column[0] = 'X'
+	if string(raw) != "hex:00ff" {
+		t.Errorf("Scan into []byte shares the driver's buffer: %q", raw)
+	}
+
+	var anything interface{}
+	if err := NewScanner(registry, &anything).Scan(int64(7)); err != nil || anything != int64(7) {
+		t.Errorf("Scan(int64(7)) into interface{} = %v, %v; expected 7", anything, err)
+	}
+
+	var flag bool
+	if err := NewScanner(registry, flag).Scan("true"); err == nil {
+		t.Error("Scan into a non-pointer destination should fail")
+	}
+	if err := NewScanner(registry, nil).Scan("1"); err == nil {
+		t.Error("Scan into a nil destination should fail")
+	}
+	var nilPointer *int
+	if err := NewScanner(registry, nilPointer).Scan("1"); err == nil {
+		t.Error("Scan into a nil pointer destination should fail")
+	}
+}
+
+func TestRegisterNull(t *testing.T) {
+	registry := newTestRegistry()
+	if err := RegisterNull[int16](registry); err != nil {
+		t.Fatalf("RegisterNull[int16] unexpected error: %v", err)
+	}
+	if err := RegisterNull[string](registry); err != nil {
+		t.Fatalf("RegisterNull[string] unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		input      string
+		targetType reflect.Type
+		expected   interface{}
+		hasError   bool
+	}{
+		{"int16", "-12", reflect.TypeOf(sql.Null[int16]{}), sql.Null[int16]{V: -12, Valid: true}, false},
+		{"empty int16 is null", "", reflect.TypeOf(sql.Null[int16]{}), sql.Null[int16]{}, false},
+		{"int16 out of range", "40000", reflect.TypeOf(sql.Null[int16]{}), nil, true},
+		{"string", "text", reflect.TypeOf(sql.Null[string]{}), sql.Null[string]{V: "text", Valid: true}, false},
+		{"empty string is valid", "", reflect.TypeOf(sql.Null[string]{}), sql.Null[string]{Valid: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.Convert(tt.input, tt.targetType)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Convert(%q) expected error, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Convert(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("Convert(%q) = %#v, expected %#v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	if err := RegisterNull[chan int](registry); err == nil {
+		t.Error("RegisterNull without a converter for the value type should fail")
+	}
+
+	// sql.Null[T] works with the Scanner as well
+	var score sql.Null[int16]
+	if err := NewScanner(registry, &score).Scan([]byte("9")); err != nil || score != (sql.Null[int16]{V: 9, Valid: true}) {
+		t.Errorf("Scan into sql.Null[int16] = %v, %v", score, err)
+	}
+}
@@ -0,0 +1,72 @@
+package str2gotest
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// interesting are fragments that commonly trip parsers: signs, separators, exponents,
+// special values, whitespace and multi-byte or invalid UTF-8
+var interesting = []string{
+	"", "0", "-", "+", ".", ",", "e", "E", "e308", "e-324", "x", "0x", "_", ":", "/", "%",
+	"NaN", "Inf", "-Inf", " ", "\t", "\n", "\x00", "µ", "\u00a0", "\xff", "9999999999999999999999",
+}
+
+// FuzzInputs returns n inputs derived from corpus with a generator seeded by seed, so the same
+// arguments always give the same inputs. Each input is a corpus entry, a fragment that commonly
+// trips parsers, or random bytes, mutated a few times by inserting, deleting, replacing,
+// duplicating or truncating.
+func FuzzInputs(seed uint64, n int, corpus []string) []string {
+	rng := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+	inputs := make([]string, 0, n)
+	for len(inputs) < n {
+		var input string
+		switch rng.IntN(3) {
+		case 0:
+			if len(corpus) > 0 {
+				input = corpus[rng.IntN(len(corpus))]
+			}
+		case 1:
+			input = interesting[rng.IntN(len(interesting))]
+		default:
+			input = randomBytes(rng, rng.IntN(16))
+		}
+		for mutations := rng.IntN(4); mutations > 0; mutations-- {
+			input = mutate(rng, input)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+func randomBytes(rng *rand.Rand, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteByte(byte(rng.IntN(256)))
+	}
+	return b.String()
+}
+
+// mutate applies one random edit to input
+func mutate(rng *rand.Rand, input string) string {
+	position := 0
+	if len(input) > 0 {
+		position = rng.IntN(len(input) + 1)
+	}
+	fragment := interesting[rng.IntN(len(interesting))]
+	switch rng.IntN(5) {
+	case 0:
+		return input[:position] + fragment + input[position:]
+	case 1:
+		if position < len(input) {
+			return input[:position] + input[position+1:]
+		}
+	case 2:
+		if position < len(input) {
+			return input[:position] + string(rune(rng.IntN(128))) + input[position+1:]
+		}
+	case 3:
+		return input + input
+	}
+	return input[:position]
+}
@@ -0,0 +1,27 @@
+package str2gotest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzInputs(t *testing.T) {
+	corpus := []string{"12", "abc"}
+
+	first := FuzzInputs(3, 100, corpus)
+	if len(first) != 100 {
+		t.Fatalf("FuzzInputs returned %d inputs, expected 100", len(first))
+	}
+	if again := FuzzInputs(3, 100, corpus); !reflect.DeepEqual(first, again) {
+		t.Error("FuzzInputs with the same seed should return the same inputs")
+	}
+	if other := FuzzInputs(4, 100, corpus); reflect.DeepEqual(first, other) {
+		t.Error("FuzzInputs with different seeds should return different inputs")
+	}
+	if empty := FuzzInputs(3, 10, nil); len(empty) != 10 {
+		t.Errorf("FuzzInputs without a corpus returned %d inputs, expected 10", len(empty))
+	}
+	if none := FuzzInputs(3, 0, corpus); len(none) != 0 {
+		t.Errorf("FuzzInputs(0) returned %d inputs, expected none", len(none))
+	}
+}
@@ -0,0 +1,436 @@
+// Package str2gotest runs a conformance suite against converters, so custom converters get
+// the same checks as the defaults with one call from any _test.go file:
+//
+//	func TestCelsiusConverter(t *testing.T) {
+//		str2gotest.Run(t, StringToCelsius, reflect.TypeOf(Celsius(0)), str2gotest.Options{
+//			Valid:   []string{"21.5", "-40"},
+//			Invalid: []string{"", "warm"},
+//		})
+//	}
+package str2gotest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/globalregistry"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+const (
+	defaultFuzzInputs = 256
+	defaultGoroutines = 8
+)
+
+// ErrorClass selects the errors a converter is expected to return
+type ErrorClass int
+
+const (
+	// ErrorAny accepts any non-nil error
+	ErrorAny ErrorClass = iota
+	// ErrorConversion requires a *model.ConversionError, anywhere in the chain, whose Value
+	// is the input and whose Type is the target type
+	ErrorConversion
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorAny:
+		return "any"
+	case ErrorConversion:
+		return "conversion"
+	}
+	return fmt.Sprintf("ErrorClass(%d)", int(c))
+}
+
+// Options configures the conformance suite
+type Options struct {
+	// Valid are inputs the converter must accept
+	Valid []string
+	// Invalid are inputs the converter must reject
+	Invalid []string
+	// ResultType is the dynamic type of successful results; it defaults to the target type and
+	// is set for converters that return a wider type, such as int64 from StringToInt8
+	ResultType reflect.Type
+	// AllowNil accepts nil results, and nil pointers, without an error, as produced for null
+	// tokens by TypeRegistry.EnableNulls
+	AllowNil bool
+	// Format formats results for the round-trip check; it defaults to the global formatter for
+	// ResultType, and the check is skipped when there is none
+	Format model.FormatterFunc
+	// Equal compares results; by default pointers are compared by what they point to, types
+	// with an Equal or Cmp method, such as time.Time and big.Int, with that method, NaN equals
+	// itself, and anything else is compared with reflect.DeepEqual
+	Equal func(a, b interface{}) bool
+	// Errors is the class of error expected for rejected inputs
+	Errors ErrorClass
+	// Seed makes the generated fuzz inputs reproducible; failures report the seed in use
+	Seed uint64
+	// FuzzInputs is the number of inputs generated from Valid and Invalid, 256 by default;
+	// negative values disable fuzzing
+	FuzzInputs int
+	// Goroutines is the number of goroutines converting concurrently, 8 by default
+	Goroutines int
+}
+
+// Run checks convert against targetType with every property of the suite, each as a subtest:
+// result types, nil pointers, round-trips through a formatter, error classes, concurrency
+// safety and generated inputs
+func Run(t *testing.T, convert model.ConverterFunc, targetType reflect.Type, opts Options) {
+	t.Helper()
+	s := newSuite(convert, targetType, opts)
+	if len(s.opts.Valid) == 0 {
+		t.Fatal("str2gotest: Options.Valid needs at least one input")
+	}
+
+	t.Run("ResultType", func(t *testing.T) {
+		for _, input := range s.opts.Valid {
+			result, err := s.convert(input)
+			if err == nil {
+				err = s.checkResult(input, result)
+			}
+			if err != nil {
+				t.Errorf("%q: %v", input, err)
+			}
+		}
+	})
+	t.Run("NilPointer", func(t *testing.T) {
+		for _, input := range s.inputs() {
+			result, err := s.convert(input)
+			if problem := s.checkNil(input, result, err); problem != nil {
+				t.Errorf("%q: %v", input, problem)
+			}
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		if s.format == nil {
+			t.Skipf("no formatter for %s", s.opts.ResultType)
+		}
+		for _, input := range s.opts.Valid {
+			result, err := s.convert(input)
+			if err == nil {
+				err = s.checkRoundTrip(input, result)
+			}
+			if err != nil {
+				t.Errorf("%q: %v", input, err)
+			}
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		for _, input := range s.opts.Invalid {
+			result, err := s.convert(input)
+			if err == nil {
+				t.Errorf("%q: expected error, got %v", input, result)
+				continue
+			}
+			if problem := s.checkError(input, err); problem != nil {
+				t.Errorf("%q: %v", input, problem)
+			}
+		}
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		if err := s.checkConcurrency(s.inputs()); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("Fuzz", func(t *testing.T) {
+		if s.opts.FuzzInputs < 0 {
+			t.Skip("fuzzing disabled")
+		}
+		corpus := s.inputs()
+		for _, input := range FuzzInputs(s.opts.Seed, s.opts.FuzzInputs, corpus) {
+			if err := s.checkInput(input); err != nil {
+				t.Errorf("%q (seed %d): %v", input, s.opts.Seed, err)
+			}
+		}
+	})
+}
+
+// CheckInput converts a single input and reports any broken property, without requiring the
+// input to be valid; it suits native fuzz targets:
+//
+//	f.Fuzz(func(t *testing.T, input string) {
+//		str2gotest.CheckInput(t, StringToCelsius, reflect.TypeOf(Celsius(0)), opts, input)
+//	})
+func CheckInput(t testing.TB, convert model.ConverterFunc, targetType reflect.Type, opts Options, input string) {
+	t.Helper()
+	if err := newSuite(convert, targetType, opts).checkInput(input); err != nil {
+		t.Errorf("%q: %v", input, err)
+	}
+}
+
+// suite holds a converter and the options with defaults filled in
+type suite struct {
+	convertFunc model.ConverterFunc
+	targetType  reflect.Type
+	format      model.FormatterFunc
+	opts        Options
+}
+
+func newSuite(convert model.ConverterFunc, targetType reflect.Type, opts Options) *suite {
+	if opts.ResultType == nil {
+		opts.ResultType = targetType
+	}
+	if opts.Equal == nil {
+		opts.Equal = equal
+	}
+	if opts.FuzzInputs == 0 {
+		opts.FuzzInputs = defaultFuzzInputs
+	}
+	if opts.Goroutines <= 0 {
+		opts.Goroutines = defaultGoroutines
+	}
+	format := opts.Format
+	if format == nil {
+		format, _ = globalregistry.GetFormatter(opts.ResultType)
+	}
+	return &suite{convertFunc: convert, targetType: targetType, format: format, opts: opts}
+}
+
+// inputs returns the valid inputs followed by the invalid ones
+func (s *suite) inputs() []string {
+	inputs := make([]string, 0, len(s.opts.Valid)+len(s.opts.Invalid))
+	return append(append(inputs, s.opts.Valid...), s.opts.Invalid...)
+}
+
+// convert calls the converter, turning a panic into an error
+func (s *suite) convert(input string) (result interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = nil, &panicError{value: recovered}
+		}
+	}()
+	return s.convertFunc(input)
+}
+
+// panicError records a converter panic; it is never an acceptable rejection
+type panicError struct {
+	value interface{}
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("converter panicked: %v", e.value)
+}
+
+// checkInput checks every property that applies to a single input
+func (s *suite) checkInput(input string) error {
+	result, err := s.convert(input)
+	if problem := s.checkNil(input, result, err); problem != nil {
+		return problem
+	}
+	if err != nil {
+		return s.checkError(input, err)
+	}
+	if problem := s.checkResult(input, result); problem != nil {
+		return problem
+	}
+	if s.format == nil || result == nil {
+		return nil
+	}
+	return s.checkRoundTrip(input, result)
+}
+
+// checkResult checks that a successful result has the expected dynamic type
+func (s *suite) checkResult(input string, result interface{}) error {
+	if result == nil {
+		if s.opts.AllowNil {
+			return nil
+		}
+		return errors.New("converter returned nil without an error")
+	}
+	if resultType := reflect.TypeOf(result); resultType != s.opts.ResultType {
+		return fmt.Errorf("result has type %s, expected %s", resultType, s.opts.ResultType)
+	}
+	return nil
+}
+
+// checkNil checks that successful pointer results are not nil, unless allowed, and that failed
+// conversions do not return a nil pointer in a non-nil interface, which callers comparing the
+// result to nil would take for a value
+func (s *suite) checkNil(input string, result interface{}, err error) error {
+	if result == nil {
+		return nil
+	}
+	v := reflect.ValueOf(result)
+	if !isNilable(v.Kind()) || !v.IsNil() {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed conversion returned a typed nil %s instead of nil", v.Type())
+	}
+	if !s.opts.AllowNil {
+		return fmt.Errorf("converter returned a nil %s without an error", v.Type())
+	}
+	return nil
+}
+
+func isNilable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
+
+// checkError checks that err belongs to the expected class
+func (s *suite) checkError(input string, err error) error {
+	var panicked *panicError
+	if errors.As(err, &panicked) {
+		return panicked
+	}
+	if err.Error() == "" {
+		return errors.New("error has an empty message")
+	}
+	if s.opts.Errors != ErrorConversion {
+		return nil
+	}
+	var conversion *model.ConversionError
+	if !errors.As(err, &conversion) {
+		return fmt.Errorf("error %q (%T) is not a *model.ConversionError", err, err)
+	}
+	if conversion.Value != input {
+		return fmt.Errorf("ConversionError.Value = %q, expected the input", conversion.Value)
+	}
+	if conversion.Type != s.targetType {
+		return fmt.Errorf("ConversionError.Type = %v, expected %s", conversion.Type, s.targetType)
+	}
+	return nil
+}
+
+// checkRoundTrip checks that formatting result and converting the text gives result again
+func (s *suite) checkRoundTrip(input string, result interface{}) error {
+	text, err := s.format(result)
+	if err != nil {
+		return fmt.Errorf("formatting %v failed: %w", result, err)
+	}
+	again, err := s.convert(text)
+	if err != nil {
+		return fmt.Errorf("formatted as %q, which does not convert back: %w", text, err)
+	}
+	if !s.opts.Equal(result, again) {
+		return fmt.Errorf("formatted as %q, which converts to %v, expected %v", text, again, result)
+	}
+	return nil
+}
+
+// checkConcurrency converts inputs from several goroutines at once and compares the results
+// with sequential conversions; run with -race to also catch unsynchronized state
+func (s *suite) checkConcurrency(inputs []string) error {
+	type outcome struct {
+		result interface{}
+		failed bool
+	}
+	expected := make([]outcome, len(inputs))
+	for i, input := range inputs {
+		result, err := s.convert(input)
+		expected[i] = outcome{result, err != nil}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var problems []error
+	for g := 0; g < s.opts.Goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, input := range inputs {
+				result, err := s.convert(input)
+				if (err != nil) == expected[i].failed && (err != nil || s.opts.Equal(result, expected[i].result)) {
+					continue
+				}
+				mu.Lock()
+				problems = append(problems, fmt.Errorf("%q: concurrent conversion gave %v, %v; sequential gave %v", input, result, err, expected[i].result))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(problems...)
+}
+
+// equal compares results by value: pointers by what they point to, types with an Equal or Cmp
+// method, such as time.Time and *big.Float, with that method, floats with NaN equal to itself,
+// and structs with only exported fields field by field; anything else with reflect.DeepEqual
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalValues(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func equalValues(va, vb reflect.Value) bool {
+	if va.Type() != vb.Type() {
+		return false
+	}
+	if va.Kind() == reflect.Pointer {
+		if va.IsNil() || vb.IsNil() {
+			return va.IsNil() == vb.IsNil()
+		}
+		if same, ok := compareWithMethod(va, vb); ok {
+			return same
+		}
+		return equalValues(va.Elem(), vb.Elem())
+	}
+	if same, ok := compareWithMethod(va, vb); ok {
+		return same
+	}
+	// Methods with pointer receivers, such as (*big.Float).Cmp, need addressable copies
+	pa, pb := reflect.New(va.Type()), reflect.New(vb.Type())
+	pa.Elem().Set(va)
+	pb.Elem().Set(vb)
+	if same, ok := compareWithMethod(pa, pb); ok {
+		return same
+	}
+
+	switch va.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return sameFloat(va.Float(), vb.Float())
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := va.Complex(), vb.Complex()
+		return sameFloat(real(ca), real(cb)) && sameFloat(imag(ca), imag(cb))
+	case reflect.Struct:
+		if exportedFields(va.Type()) {
+			for i := 0; i < va.NumField(); i++ {
+				if !equalValues(va.Field(i), vb.Field(i)) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return reflect.DeepEqual(va.Interface(), vb.Interface())
+}
+
+// compareWithMethod compares with an Equal(T) bool or Cmp(T) int method of T, reporting
+// whether T has either
+func compareWithMethod(va, vb reflect.Value) (same bool, ok bool) {
+	if method := va.MethodByName("Equal"); method.IsValid() && hasSignature(method.Type(), va.Type(), reflect.Bool) {
+		return method.Call([]reflect.Value{vb})[0].Bool(), true
+	}
+	if method := va.MethodByName("Cmp"); method.IsValid() && hasSignature(method.Type(), va.Type(), reflect.Int) {
+		return method.Call([]reflect.Value{vb})[0].Int() == 0, true
+	}
+	return false, false
+}
+
+func hasSignature(methodType, argType reflect.Type, result reflect.Kind) bool {
+	return methodType.NumIn() == 1 && methodType.In(0) == argType &&
+		methodType.NumOut() == 1 && methodType.Out(0).Kind() == result
+}
+
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
+
+func exportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,235 @@
+package str2gotest
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"math/big"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// TestRunDefaultConverters tests that default converters pass the suite
+func TestRunDefaultConverters(t *testing.T) {
+	numberConverter, err := converter.NewNumberConverter(reflect.TypeOf(int16(0)), converter.NumberOptions{})
+	if err != nil {
+		t.Fatalf("NewNumberConverter unexpected error: %v", err)
+	}
+
+	t.Run("int", func(t *testing.T) {
+		Run(t, converter.StringToInt, reflect.TypeOf(0), Options{
+			Valid:   []string{"0", "-42", "9223372036854775807"},
+			Invalid: []string{"", "4.2", "abc"},
+		})
+	})
+	t.Run("int8 legacy result", func(t *testing.T) {
+		Run(t, converter.StringToInt8, reflect.TypeOf(int8(0)), Options{
+			Valid:      []string{"-128", "127"},
+			Invalid:    []string{"128"},
+			ResultType: reflect.TypeOf(int64(0)),
+		})
+	})
+	t.Run("time", func(t *testing.T) {
+		Run(t, converter.StringToTime, reflect.TypeOf(time.Time{}), Options{
+			Valid:   []string{"2024-02-29", "2024-02-29T12:00:00+05:30", "Mon Jan  2 15:04:05 2006"},
+			Invalid: []string{"", "yesterday"},
+			Seed:    7,
+		})
+	})
+	t.Run("url pointer", func(t *testing.T) {
+		Run(t, converter.StringToURLPtr, reflect.TypeOf(&url.URL{}), Options{
+			Valid:   []string{"https://example.com/a?b=c"},
+			Invalid: []string{"%zz"},
+			// Parsing keeps the raw forms of escaped parts, so compare the URLs as text
+			Equal: func(a, b interface{}) bool {
+				return a.(*url.URL).String() == b.(*url.URL).String()
+			},
+		})
+	})
+	t.Run("conversion errors", func(t *testing.T) {
+		Run(t, numberConverter, reflect.TypeOf(int16(0)), Options{
+			Valid:   []string{"32767", "-32768"},
+			Invalid: []string{"32768", "1.5", "x"},
+			Errors:  ErrorConversion,
+		})
+	})
+}
+
+// TestChecksDetectBrokenConverters tests that every property catches a converter breaking it
+func TestChecksDetectBrokenConverters(t *testing.T) {
+	intType := reflect.TypeOf(0)
+	var calls atomic.Int64
+
+	tests := []struct {
+		name    string
+		convert model.ConverterFunc
+		opts    Options
+		input   string
+		problem string
+	}{
+		{
+			name:    "panic",
+			convert: func(string) (interface{}, error) { panic("boom") },
+			input:   "1",
+			problem: "panicked: boom",
+		},
+		{
+			name:    "wrong result type",
+			convert: func(string) (interface{}, error) { return int64(1), nil },
+			input:   "1",
+			problem: "type int64, expected int",
+		},
+		{
+			name:    "nil result",
+			convert: func(string) (interface{}, error) { return nil, nil },
+			input:   "1",
+			problem: "nil without an error",
+		},
+		{
+			name:    "typed nil on error",
+			convert: func(string) (interface{}, error) { return (*int)(nil), errors.New("bad") },
+			input:   "x",
+			problem: "typed nil *int",
+		},
+		{
+			name:    "nil pointer on success",
+			convert: func(string) (interface{}, error) { return (*int)(nil), nil },
+			input:   "1",
+			problem: "nil *int without an error",
+		},
+		{
+			name: "round trip",
+			convert: func(value string) (interface{}, error) {
+				n, err := strconv.Atoi(value)
+				return n + 1, err
+			},
+			input:   "1",
+			problem: `formatted as "2", which converts to 3, expected 2`,
+		},
+		{
+			name: "unformattable text",
+			convert: func(value string) (interface{}, error) {
+				if value == "1" {
+					return 1, nil
+				}
+				return nil, errors.New("only 1")
+			},
+			opts:    Options{Format: func(interface{}) (string, error) { return "one", nil }},
+			input:   "1",
+			problem: `formatted as "one", which does not convert back`,
+		},
+		{
+			name:    "error class",
+			convert: func(string) (interface{}, error) { return nil, errors.New("plain") },
+			opts:    Options{Errors: ErrorConversion},
+			input:   "x",
+			problem: "is not a *model.ConversionError",
+		},
+		{
+			name: "conversion error value",
+			convert: func(string) (interface{}, error) {
+				return nil, &model.ConversionError{Value: "other", Type: intType, Offset: -1, Err: errors.New("bad")}
+			},
+			opts:    Options{Errors: ErrorConversion},
+			input:   "x",
+			problem: "ConversionError.Value",
+		},
+		{
+			name:    "empty error",
+			convert: func(string) (interface{}, error) { return nil, errors.New("") },
+			input:   "x",
+			problem: "empty message",
+		},
+		{
+			name: "stateful",
+			convert: func(string) (interface{}, error) {
+				return int(calls.Add(1)), nil
+			},
+			opts:    Options{Format: func(v interface{}) (string, error) { return strconv.Itoa(v.(int)), nil }},
+			input:   "1",
+			problem: "which converts to",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newSuite(tt.convert, intType, tt.opts).checkInput(tt.input)
+			if err == nil {
+				t.Fatalf("checkInput(%q) expected error, got nil", tt.input)
+			}
+			if !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("checkInput(%q) = %v, expected it to mention %q", tt.input, err, tt.problem)
+			}
+		})
+	}
+}
+
+// TestCheckConcurrency tests that results differing between goroutines are reported
+func TestCheckConcurrency(t *testing.T) {
+	var calls atomic.Int64
+	stateful := func(string) (interface{}, error) {
+		return int(calls.Add(1)), nil
+	}
+
+	s := newSuite(stateful, reflect.TypeOf(0), Options{Goroutines: 2})
+	if err := s.checkConcurrency([]string{"a"}); err == nil {
+		t.Error("checkConcurrency expected error for a stateful converter, got nil")
+	}
+
+	s = newSuite(converter.StringToInt, reflect.TypeOf(0), Options{})
+	if err := s.checkConcurrency([]string{"1", "x"}); err != nil {
+		t.Errorf("checkConcurrency unexpected error: %v", err)
+	}
+}
+
+// TestEqual tests the default comparison of results
+func TestEqual(t *testing.T) {
+	instant := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var nilTime *time.Time
+	nan := math.NaN()
+
+	tests := []struct {
+		name     string
+		a, b     interface{}
+		expected bool
+	}{
+		{"equal method", instant, instant.In(time.FixedZone("", 3600)), true},
+		{"equal method differs", instant, instant.Add(time.Second), false},
+		{"pointer equal method", &instant, &instant, true},
+		{"nil pointer", nilTime, &instant, false},
+		{"deep equal", []int{1}, []int{1}, true},
+		{"different types", 1, int64(1), false},
+		{"nan", math.NaN(), math.NaN(), true},
+		{"pointer to nan", &nan, &nan, true},
+		{"complex nan", complex(math.NaN(), 1), complex(math.NaN(), 1), true},
+		{"struct with nan", sql.NullFloat64{Float64: math.NaN(), Valid: true}, sql.NullFloat64{Float64: math.NaN(), Valid: true}, true},
+		{"struct differs", sql.NullFloat64{Float64: 1, Valid: true}, sql.NullFloat64{Float64: 1}, false},
+		{"pointer cmp method", big.NewInt(5), big.NewInt(5), true},
+		{"value cmp method", *new(big.Float).SetPrec(64).SetInt64(3), *new(big.Float).SetPrec(200).SetInt64(3), true},
+		{"value cmp differs", *big.NewInt(5), *big.NewInt(6), false},
+		{"nil", nil, nil, true},
+		{"one nil", nil, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := equal(tt.a, tt.b); result != tt.expected {
+				t.Errorf("equal(%v, %v) = %v, expected %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestErrorClassString(t *testing.T) {
+	if ErrorConversion.String() != "conversion" || ErrorClass(9).String() != "ErrorClass(9)" {
+		t.Errorf("unexpected ErrorClass strings: %v, %v", ErrorConversion, ErrorClass(9))
+	}
+}
@@ -0,0 +1,193 @@
+package typeregistry
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// CoverageOptions configures the coverage mode enabled by EnableCoverage
+type CoverageOptions struct {
+	// RuneChars lets unregistered rune (int32) targets accept a single character such as "é" or a quoted
+	// rune literal such as 'é' in addition to numbers
+	RuneChars bool
+	// ByteChars lets unregistered byte (uint8) targets accept a single ASCII character such as "A" or a
+	// quoted byte literal such as '\x41' in addition to numbers
+	ByteChars bool
+}
+
+// EnableCoverage guarantees a converter for every scalar reflect.Kind. Types without a
+// registered converter, including named types such as `type Port uint16` and pointers to
+// them, are converted according to their kind and produce values of exactly that type.
+func (tr *TypeRegistry) EnableCoverage(opts CoverageOptions) {
+	tr.coverage = &opts
+}
+
+var (
+	runeType = reflect.TypeOf(rune(0))
+	byteType = reflect.TypeOf(byte(0))
+)
+
+// charConverter returns a character-aware converter for rune and byte, and their pointers,
+// when the matching option is set. It is only used for types without a registered converter,
+// and named types such as `type level uint8` keep plain numeric semantics.
+func charConverter(t reflect.Type, opts CoverageOptions) (model.ConverterFunc, bool) {
+	base := t
+	if base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if (base != runeType || !opts.RuneChars) && (base != byteType || !opts.ByteChars) {
+		return nil, false
+	}
+
+	return func(value string) (interface{}, error) {
+		v := reflect.New(base)
+		if err := setChar(v.Elem(), value); err != nil {
+			return nil, &model.ConversionError{Value: value, Type: t, Offset: -1, Err: err}
+		}
+		if t.Kind() == reflect.Pointer {
+			return v.Interface(), nil
+		}
+		return v.Elem().Interface(), nil
+	}, true
+}
+
+// kindConverter derives a converter from the kind of t, or of t's element when t is a pointer
+func kindConverter(t reflect.Type) (model.ConverterFunc, bool) {
+	base := t
+	if base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if !isScalarKind(base.Kind()) {
+		return nil, false
+	}
+
+	return func(value string) (interface{}, error) {
+		v := reflect.New(base)
+		if err := setKind(v.Elem(), value); err != nil {
+			return nil, &model.ConversionError{Value: value, Type: t, Offset: -1, Err: err}
+		}
+		if t.Kind() == reflect.Pointer {
+			return v.Interface(), nil
+		}
+		return v.Elem().Interface(), nil
+	}, true
+}
+
+func isScalarKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// setKind parses value according to the kind of v and stores the result in v
+func setKind(v reflect.Value, value string) error {
+	bits := v.Type().Bits
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uintptr:
+		// Addresses are usually written in hex, so accept Go literal prefixes
+		u, err := strconv.ParseUint(value, 0, bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		c, err := strconv.ParseComplex(value, bits())
+		if err != nil {
+			return err
+		}
+		v.SetComplex(c)
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+	return nil
+}
+
+// setChar stores a character in a rune or byte value. Numbers keep their numeric meaning,
+// so "7" is 7 while "'7'" is the character code of '7'.
+func setChar(v reflect.Value, value string) error {
+	if strings.HasPrefix(value, "'") {
+		return setCharCode(v, value)
+	}
+	if err := setKind(v, value); err == nil {
+		return nil
+	}
+	if utf8.RuneCountInString(value) != 1 {
+		return fmt.Errorf("expected a number, a single character or a quoted character literal")
+	}
+
+	r, _ := utf8.DecodeRuneInString(value)
+	if r == utf8.RuneError {
+		return errors.New("invalid UTF-8 character")
+	}
+	if v.Kind() == reflect.Uint8 {
+		if r >= utf8.RuneSelf {
+			return fmt.Errorf("character %q is not ASCII", r)
+		}
+		v.SetUint(uint64(r))
+		return nil
+	}
+	v.SetInt(int64(r))
+	return nil
+}
+
+// setCharCode parses a quoted literal such as 'é', '\n' or '\u00e9'
+func setCharCode(v reflect.Value, value string) error {
+	if len(value) < 3 || !strings.HasSuffix(value, "'") {
+		return fmt.Errorf("unterminated character literal")
+	}
+	r, multibyte, tail, err := strconv.UnquoteChar(value[1:len(value)-1], '\'')
+	if err != nil {
+		return fmt.Errorf("invalid character literal: %w", err)
+	}
+	if tail != "" {
+		return fmt.Errorf("character literal must contain exactly one character")
+	}
+
+	if v.Kind() == reflect.Uint8 {
+		// Escapes such as '\xff' denote a byte directly; other characters must be ASCII
+		if r > 0xff || (multibyte && r >= utf8.RuneSelf) {
+			return fmt.Errorf("character %q does not fit in a byte", r)
+		}
+		v.SetUint(uint64(r))
+		return nil
+	}
+	v.SetInt(int64(r))
+	return nil
+}
@@ -0,0 +1,268 @@
+package typeregistry
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+type testPort uint16
+
+type testRatio float32
+
+type testName string
+
+// TestCoverageDisabled tests that unregistered types are not converted by default
+func TestCoverageDisabled(t *testing.T) {
+	registry := NewTypeRegistry()
+
+	if _, exists := registry.Get(reflect.TypeOf(uintptr(0))); exists {
+		t.Fatal("uintptr should not have a converter without coverage")
+	}
+	if _, err := registry.Convert("8080", reflect.TypeOf(testPort(0))); err == nil {
+		t.Fatal("named types should not convert without coverage")
+	}
+}
+
+// TestCoverageEveryScalarKind tests that every scalar kind gets a converter producing the exact type
+func TestCoverageEveryScalarKind(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.EnableCoverage(CoverageOptions{})
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"true", true},
+		{"text", "text"},
+		{"-1", int(-1)},
+		{"-8", int8(-8)},
+		{"-16", int16(-16)},
+		{"-32", int32(-32)},
+		{"-64", int64(-64)},
+		{"1", uint(1)},
+		{"8", uint8(8)},
+		{"16", uint16(16)},
+		{"32", uint32(32)},
+		{"64", uint64(64)},
+		{"0xdeadbeef", uintptr(0xdeadbeef)},
+		{"1.5", float32(1.5)},
+		{"2.5", float64(2.5)},
+		{"1+2i", complex64(complex(1, 2))},
+		{"3-4i", complex128(complex(3, -4))},
+		{"8080", testPort(8080)},
+		{"0.25", testRatio(0.25)},
+		{"alice", testName("alice")},
+	}
+
+	for _, tt := range tests {
+		targetType := reflect.TypeOf(tt.expected)
+		result, err := registry.Convert(tt.input, targetType)
+		if err != nil {
+			t.Errorf("Convert(%q, %s) unexpected error: %v", tt.input, targetType, err)
+			continue
+		}
+		if result != tt.expected {
+			t.Errorf("Convert(%q, %s) = %#v, expected %#v", tt.input, targetType, result, tt.expected)
+		}
+
+		// Pointers to scalar kinds are covered as well
+		ptrResult, err := registry.Convert(tt.input, reflect.PointerTo(targetType))
+		if err != nil {
+			t.Errorf("Convert(%q, *%s) unexpected error: %v", tt.input, targetType, err)
+			continue
+		}
+		if reflect.TypeOf(ptrResult) != reflect.PointerTo(targetType) || reflect.ValueOf(ptrResult).Elem().Interface() != tt.expected {
+			t.Errorf("Convert(%q, *%s) = %#v", tt.input, targetType, ptrResult)
+		}
+	}
+}
+
+// TestCoverageErrors tests that kind converters report range and syntax errors
+func TestCoverageErrors(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.EnableCoverage(CoverageOptions{})
+
+	tests := []struct {
+		input      string
+		targetType reflect.Type
+	}{
+		{"65536", reflect.TypeOf(testPort(0))},
+		{"-1", reflect.TypeOf(uint(0))},
+		{"128", reflect.TypeOf(int8(0))},
+		{"1e39", reflect.TypeOf(float32(0))},
+		{"maybe", reflect.TypeOf(false)},
+		{"A", reflect.TypeOf(rune(0))},
+		{"A", reflect.TypeOf(byte(0))},
+	}
+
+	for _, tt := range tests {
+		result, err := registry.Convert(tt.input, tt.targetType)
+		var conversionErr *model.ConversionError
+		if !errors.As(err, &conversionErr) {
+			t.Errorf("Convert(%q, %s) = %v, %v; expected *model.ConversionError", tt.input, tt.targetType, result, err)
+			continue
+		}
+		if conversionErr.Type != tt.targetType {
+			t.Errorf("Convert(%q, %s) error has type %s", tt.input, tt.targetType, conversionErr.Type)
+		}
+	}
+
+	if _, exists := registry.Get(reflect.TypeOf(struct{}{})); exists {
+		t.Error("coverage should not provide converters for non-scalar kinds")
+	}
+	if _, exists := registry.Get(reflect.TypeOf([]int{})); exists {
+		t.Error("coverage should not provide converters for slices")
+	}
+}
+
+// TestCoverageKeepsRegisteredConverters tests that registered converters take precedence
+func TestCoverageKeepsRegisteredConverters(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.Register(reflect.TypeOf(testPort(0)), func(value string) (interface{}, error) {
+		return testPort(1), nil
+	})
+	registry.EnableCoverage(CoverageOptions{RuneChars: true, ByteChars: true})
+
+	result, err := registry.Convert("8080", reflect.TypeOf(testPort(0)))
+	if err != nil || result != testPort(1) {
+		t.Fatalf("registered converter should be used, got %v, %v", result, err)
+	}
+}
+
+// TestCoverageKeepsRegisteredCharTypes tests that character semantics do not replace
+// converters registered for int32 and uint8
+func TestCoverageKeepsRegisteredCharTypes(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.RegisterAll(converter.NumberConverters(converter.NumberOptions{
+		Format:   converter.NumberFormat{GroupSeparator: ','},
+		Overflow: converter.OverflowSaturate,
+	}))
+
+	check := func(mode string) {
+		t.Helper()
+		if result, err := registry.Convert("1,234", reflect.TypeOf(int32(0))); err != nil || result != int32(1234) {
+			t.Errorf("%s: Convert(%q, int32) = %v, %v; expected 1234", mode, "1,234", result, err)
+		}
+		if result, err := registry.Convert("300", reflect.TypeOf(uint8(0))); err != nil || result != uint8(255) {
+			t.Errorf("%s: Convert(%q, uint8) = %v, %v; expected 255", mode, "300", result, err)
+		}
+		if _, err := registry.Convert("A", reflect.TypeOf(uint8(0))); err == nil {
+			t.Errorf("%s: Convert(%q, uint8) expected error from the registered converter, got nil", mode, "A")
+		}
+	}
+
+	check("before coverage")
+	registry.EnableCoverage(CoverageOptions{RuneChars: true, ByteChars: true})
+	check("after coverage")
+}
+
+// TestCoverageRuneChars tests character semantics for rune targets
+func TestCoverageRuneChars(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.EnableCoverage(CoverageOptions{RuneChars: true})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected rune
+		hasError bool
+	}{
+		{"ASCII character", "A", 'A', false},
+		{"unicode character", "é", 'é', false},
+		{"emoji", "🙂", '🙂', false},
+		{"quoted character", "'é'", 'é', false},
+		{"unicode escape", `'\u00e9'`, 'é', false},
+		{"newline escape", `'\n'`, '\n', false},
+		{"quoted quote", `'\''`, '\'', false},
+		{"digit stays numeric", "7", 7, false},
+		{"quoted digit", "'7'", '7', false},
+		{"number", "233", 233, false},
+		{"negative number", "-1", -1, false},
+		{"two characters", "ab", 0, true},
+		{"empty quotes", "''", 0, true},
+		{"two quoted characters", "'ab'", 0, true},
+		{"unterminated quote", "'a", 0, true},
+		{"empty string", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.Convert(tt.input, reflect.TypeOf(rune(0)))
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Convert(%q) expected error, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Convert(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("Convert(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	result, err := registry.Convert("é", reflect.TypeOf(new(rune)))
+	if err != nil || *result.(*rune) != 'é' {
+		t.Errorf("*rune Convert = %v, %v; expected 'é'", result, err)
+	}
+
+	// Byte semantics are not enabled, so byte still requires a number
+	if _, err := registry.Convert("A", reflect.TypeOf(byte(0))); err == nil {
+		t.Error("byte should not accept characters unless ByteChars is set")
+	}
+}
+
+// TestCoverageByteChars tests character semantics for byte targets
+func TestCoverageByteChars(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.EnableCoverage(CoverageOptions{ByteChars: true})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected byte
+		hasError bool
+	}{
+		{"ASCII character", "A", 'A', false},
+		{"quoted character", "'z'", 'z', false},
+		{"hex escape", `'\x41'`, 0x41, false},
+		{"high hex escape", `'\xff'`, 0xff, false},
+		{"number", "200", 200, false},
+		{"non-ASCII character", "é", 0, true},
+		{"quoted non-ASCII character", "'é'", 0, true},
+		{"unicode escape beyond byte", `'\u0100'`, 0, true},
+		{"number out of range", "256", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.Convert(tt.input, reflect.TypeOf(byte(0)))
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Convert(%q) expected error, got %v", tt.input, result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Convert(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("Convert(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	// Named byte types keep plain numeric semantics
+	type level uint8
+	if _, err := registry.Convert("A", reflect.TypeOf(level(0))); err == nil {
+		t.Error("named uint8 types should not get character semantics")
+	}
+}
@@ -0,0 +1,180 @@
+package typeregistry
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnumOptions configures how enum names are matched
+type EnumOptions struct {
+	// Aliases maps additional spellings to a canonical enum name
+	Aliases map[string]string
+	// CaseInsensitive matches names and aliases regardless of case
+	CaseInsensitive bool
+}
+
+// EnumError is returned when a string does not name a registered enum value
+type EnumError struct {
+	Value      string
+	Type       reflect.Type
+	Options    []string
+	Suggestion string
+}
+
+func (e *EnumError) Error() string {
+	msg := fmt.Sprintf("invalid %s value %q; valid options: %s", e.Type, e.Value, strings.Join(e.Options, ", "))
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", e.Suggestion)
+	}
+	return msg
+}
+
+// RegisterEnum registers converters for enumType and *enumType that map names to values.
+// Every value must be convertible to enumType.
+func (tr *TypeRegistry) RegisterEnum(enumType reflect.Type, values map[string]interface{}, opts EnumOptions) error {
+	enum, err := newEnum(enumType, values, opts)
+	if err != nil {
+		return err
+	}
+
+	tr.Register(enumType, enum.parse)
+	tr.Register(reflect.PointerTo(enumType), func(value string) (interface{}, error) {
+		enumValue, err := enum.lookup(value)
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(enumType)
+		ptr.Elem().Set(enumValue)
+		return ptr.Interface(), nil
+	})
+	return nil
+}
+
+type enum struct {
+	typ             reflect.Type
+	values          map[string]reflect.Value
+	display         map[string]string
+	names           []string
+	caseInsensitive bool
+}
+
+func newEnum(enumType reflect.Type, values map[string]interface{}, opts EnumOptions) (*enum, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("enum %s has no values", enumType)
+	}
+
+	e := &enum{
+		typ:             enumType,
+		values:          make(map[string]reflect.Value, len(values)+len(opts.Aliases)),
+		display:         make(map[string]string, len(values)+len(opts.Aliases)),
+		names:           make([]string, 0, len(values)),
+		caseInsensitive: opts.CaseInsensitive,
+	}
+
+	for name, value := range values {
+		v := reflect.ValueOf(value)
+		if !v.IsValid() || !v.Type().ConvertibleTo(enumType) {
+			return nil, fmt.Errorf("enum %s: value for %q has type %T, not convertible to %s", enumType, name, value, enumType)
+		}
+		if err := e.add(name, v.Convert(enumType)); err != nil {
+			return nil, err
+		}
+		e.names = append(e.names, name)
+	}
+	sort.Strings(e.names)
+
+	for alias, name := range opts.Aliases {
+		v, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("enum %s: alias %q refers to unknown name %q", enumType, alias, name)
+		}
+		if err := e.add(alias, reflect.ValueOf(v).Convert(enumType)); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func (e *enum) key(name string) string {
+	if e.caseInsensitive {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+func (e *enum) add(name string, value reflect.Value) error {
+	key := e.key(name)
+	if existing, ok := e.values[key]; ok && existing.Interface() != value.Interface() {
+		return fmt.Errorf("enum %s: name %q is ambiguous", e.typ, name)
+	}
+	e.values[key] = value
+	e.display[key] = name
+	return nil
+}
+
+func (e *enum) lookup(value string) (reflect.Value, error) {
+	if v, ok := e.values[e.key(strings.TrimSpace(value))]; ok {
+		return v, nil
+	}
+	return reflect.Value{}, &EnumError{
+		Value:      value,
+		Type:       e.typ,
+		Options:    e.names,
+		Suggestion: e.suggest(value),
+	}
+}
+
+func (e *enum) parse(value string) (interface{}, error) {
+	v, err := e.lookup(value)
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// suggest returns the closest known name or alias, or "" when nothing is close enough
+func (e *enum) suggest(value string) string {
+	input := strings.ToLower(strings.TrimSpace(value))
+	if input == "" {
+		return ""
+	}
+
+	best, bestDistance := "", -1
+	for key := range e.values {
+		distance := editDistance(input, strings.ToLower(key))
+		if bestDistance == -1 || distance < bestDistance || (distance == bestDistance && key < best) {
+			best, bestDistance = key, distance
+		}
+	}
+
+	// Allow roughly one edit per three characters, and at least two so swapped letters are caught
+	maxDistance := max(len([]rune(input))/3, 2)
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return e.display[best]
+}
+
+// editDistance computes the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,169 @@
+package typeregistry
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testLevel int
+
+const (
+	levelDebug testLevel = iota
+	levelInfo
+	levelWarn
+	levelError
+)
+
+type testColor string
+
+func levelValues() map[string]interface{} {
+	return map[string]interface{}{
+		"debug": levelDebug,
+		"info":  levelInfo,
+		"warn":  levelWarn,
+		"error": levelError,
+	}
+}
+
+// TestRegisterEnum tests converting names, aliases and pointers to enum values
+func TestRegisterEnum(t *testing.T) {
+	registry := NewTypeRegistry()
+	levelType := reflect.TypeOf(levelDebug)
+
+	err := registry.RegisterEnum(levelType, levelValues(), EnumOptions{
+		Aliases:         map[string]string{"warning": "warn", "err": "error"},
+		CaseInsensitive: true,
+	})
+	if err != nil {
+		t.Fatalf("RegisterEnum returned error: %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		expected testLevel
+	}{
+		{"debug", levelDebug},
+		{"INFO", levelInfo},
+		{"Warning", levelWarn},
+		{"err", levelError},
+		{" warn ", levelWarn},
+	}
+
+	for _, tt := range tests {
+		result, err := registry.Convert(tt.input, levelType)
+		if err != nil {
+			t.Fatalf("Convert(%q) unexpected error: %v", tt.input, err)
+		}
+		if result != tt.expected {
+			t.Fatalf("Convert(%q) = %v, expected %v", tt.input, result, tt.expected)
+		}
+	}
+
+	result, err := registry.Convert("error", reflect.PointerTo(levelType))
+	if err != nil {
+		t.Fatalf("pointer Convert unexpected error: %v", err)
+	}
+	if ptr, ok := result.(*testLevel); !ok || *ptr != levelError {
+		t.Fatalf("expected *testLevel pointing at levelError, got %v", result)
+	}
+}
+
+// TestRegisterEnumCaseSensitive tests that names must match exactly by default
+func TestRegisterEnumCaseSensitive(t *testing.T) {
+	registry := NewTypeRegistry()
+	colorType := reflect.TypeOf(testColor(""))
+
+	err := registry.RegisterEnum(colorType, map[string]interface{}{"red": "RED", "green": "GREEN"}, EnumOptions{})
+	if err != nil {
+		t.Fatalf("RegisterEnum returned error: %v", err)
+	}
+
+	result, err := registry.Convert("red", colorType)
+	if err != nil || result != testColor("RED") {
+		t.Fatalf("Convert(red) = %v, %v; expected RED", result, err)
+	}
+
+	if _, err := registry.Convert("Red", colorType); err == nil {
+		t.Fatal("expected error for case mismatch")
+	}
+}
+
+// TestRegisterEnumError tests the error message and suggestion for unknown names
+func TestRegisterEnumError(t *testing.T) {
+	registry := NewTypeRegistry()
+	levelType := reflect.TypeOf(levelDebug)
+	if err := registry.RegisterEnum(levelType, levelValues(), EnumOptions{CaseInsensitive: true}); err != nil {
+		t.Fatalf("RegisterEnum returned error: %v", err)
+	}
+
+	_, err := registry.Convert("inof", levelType)
+	var enumErr *EnumError
+	if !errors.As(err, &enumErr) {
+		t.Fatalf("expected *EnumError, got %v", err)
+	}
+	if enumErr.Suggestion != "info" {
+		t.Fatalf("expected suggestion 'info', got %q", enumErr.Suggestion)
+	}
+	if !reflect.DeepEqual(enumErr.Options, []string{"debug", "error", "info", "warn"}) {
+		t.Fatalf("unexpected options: %v", enumErr.Options)
+	}
+	if !strings.Contains(err.Error(), `did you mean "info"?`) {
+		t.Fatalf("error message should contain suggestion, got %q", err.Error())
+	}
+
+	_, err = registry.Convert("verbose", levelType)
+	if !errors.As(err, &enumErr) {
+		t.Fatalf("expected *EnumError, got %v", err)
+	}
+	if enumErr.Suggestion != "" {
+		t.Fatalf("expected no suggestion, got %q", enumErr.Suggestion)
+	}
+	if strings.Contains(err.Error(), "did you mean") {
+		t.Fatalf("error message should not contain a suggestion, got %q", err.Error())
+	}
+}
+
+// TestRegisterEnumInvalid tests that bad definitions are rejected
+func TestRegisterEnumInvalid(t *testing.T) {
+	registry := NewTypeRegistry()
+	levelType := reflect.TypeOf(levelDebug)
+
+	if err := registry.RegisterEnum(levelType, nil, EnumOptions{}); err == nil {
+		t.Fatal("expected error for empty enum")
+	}
+	if err := registry.RegisterEnum(levelType, map[string]interface{}{"debug": "zero"}, EnumOptions{}); err == nil {
+		t.Fatal("expected error for non-convertible value")
+	}
+	if err := registry.RegisterEnum(levelType, levelValues(), EnumOptions{Aliases: map[string]string{"trace": "verbose"}}); err == nil {
+		t.Fatal("expected error for alias of unknown name")
+	}
+	if err := registry.RegisterEnum(levelType, map[string]interface{}{"Info": 1, "info": 2}, EnumOptions{CaseInsensitive: true}); err == nil {
+		t.Fatal("expected error for names colliding case-insensitively")
+	}
+	if len(registry.converters) != 0 {
+		t.Fatalf("failed registrations should not add converters, got %d", len(registry.converters))
+	}
+}
+
+// TestEditDistance tests the Levenshtein distance helper
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"info", "info", 0},
+		{"inof", "info", 2},
+		{"debg", "debug", 1},
+		{"", "warn", 4},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.expected {
+			t.Errorf("editDistance(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package typeregistry
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultFlagSeparators are the separators used when FlagSetOptions.Separators is empty
+const DefaultFlagSeparators = ",|"
+
+// FlagSetOptions configures how flag-set strings are parsed and formatted
+type FlagSetOptions struct {
+	// Separators lists the characters that separate flag names; the first one is used when formatting
+	Separators string
+	// CaseInsensitive matches flag names regardless of case
+	CaseInsensitive bool
+	// AllowNumeric accepts numeric tokens such as "4" or "0x10" and formats unnamed bits numerically
+	AllowNumeric bool
+}
+
+// FlagSet maps names to bits of an integer-kinded type
+type FlagSet struct {
+	typ        reflect.Type
+	size       int
+	flags      map[string]uint64
+	names      []string
+	canonical  []flagBit
+	separators string
+	opts       FlagSetOptions
+}
+
+type flagBit struct {
+	name string
+	bit  uint64
+}
+
+// NewFlagSet creates a flag set for flagType, which must have an integer kind.
+// Names may map to a single bit or to a combination of bits such as "all".
+func NewFlagSet(flagType reflect.Type, flags map[string]uint64, opts FlagSetOptions) (*FlagSet, error) {
+	size, ok := integerBits(flagType)
+	if !ok {
+		return nil, fmt.Errorf("flag set type %s is not an integer kind", flagType)
+	}
+	if len(flags) == 0 {
+		return nil, fmt.Errorf("flag set %s has no flags", flagType)
+	}
+
+	fs := &FlagSet{
+		typ:        flagType,
+		size:       size,
+		flags:      make(map[string]uint64, len(flags)),
+		separators: opts.Separators,
+		opts:       opts,
+	}
+	if fs.separators == "" {
+		fs.separators = DefaultFlagSeparators
+	}
+
+	for name, bit := range flags {
+		if name == "" || strings.ContainsAny(name, fs.separators) || strings.HasPrefix(name, "-") || strings.HasPrefix(name, "+") {
+			return nil, fmt.Errorf("flag set %s: invalid flag name %q", flagType, name)
+		}
+		if size < 64 && bit>>size != 0 {
+			return nil, fmt.Errorf("flag set %s: flag %q (0x%x) does not fit in %d bits", flagType, name, bit, size)
+		}
+		key := fs.key(name)
+		if existing, ok := fs.flags[key]; ok && existing != bit {
+			return nil, fmt.Errorf("flag set %s: name %q is ambiguous", flagType, name)
+		}
+		fs.flags[key] = bit
+		fs.names = append(fs.names, name)
+		if bits.OnesCount64(bit) == 1 {
+			fs.canonical = append(fs.canonical, flagBit{name: name, bit: bit})
+		}
+	}
+
+	sort.Strings(fs.names)
+
+	// Single-bit names in bit order make up the canonical form; the alphabetically first name wins per bit
+	sort.Slice(fs.canonical, func(i, j int) bool {
+		if fs.canonical[i].bit != fs.canonical[j].bit {
+			return fs.canonical[i].bit < fs.canonical[j].bit
+		}
+		return fs.canonical[i].name < fs.canonical[j].name
+	})
+	return fs, nil
+}
+
+// RegisterFlags creates a flag set and registers converters for flagType and *flagType.
+// The returned FlagSet can be used to format values back to their names.
+func (tr *TypeRegistry) RegisterFlags(flagType reflect.Type, flags map[string]uint64, opts FlagSetOptions) (*FlagSet, error) {
+	fs, err := NewFlagSet(flagType, flags, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tr.Register(flagType, fs.Parse)
+	tr.Register(reflect.PointerTo(flagType), func(value string) (interface{}, error) {
+		mask, err := fs.parseMask(value)
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(flagType)
+		fs.set(ptr.Elem(), mask)
+		return ptr.Interface(), nil
+	})
+	return fs, nil
+}
+
+// Parse converts a list such as "read|write" or "all,-exec" to a value of the flag set's type.
+// Tokens are applied left to right; a leading "-" clears the named bits.
+func (fs *FlagSet) Parse(value string) (interface{}, error) {
+	mask, err := fs.parseMask(value)
+	if err != nil {
+		return nil, err
+	}
+	result := reflect.New(fs.typ).Elem()
+	fs.set(result, mask)
+	return result.Interface(), nil
+}
+
+// Format returns the canonical name list for value, which must have the flag set's type
+func (fs *FlagSet) Format(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.Type() != fs.typ {
+		return "", fmt.Errorf("cannot format %T with flag set %s", value, fs.typ)
+	}
+
+	var mask uint64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		mask = uint64(v.Int())
+		if fs.size < 64 {
+			mask &= 1<<fs.size - 1
+		}
+	default:
+		mask = v.Uint()
+	}
+
+	if mask == 0 {
+		for _, name := range fs.names {
+			if fs.flags[fs.key(name)] == 0 {
+				return name, nil
+			}
+		}
+		return "", nil
+	}
+
+	var names []string
+	remaining := mask
+	for _, flag := range fs.canonical {
+		if remaining&flag.bit != 0 {
+			names = append(names, flag.name)
+			remaining &^= flag.bit
+		}
+	}
+	if remaining != 0 {
+		if !fs.opts.AllowNumeric {
+			return "", fmt.Errorf("value 0x%x of %s has unnamed bits 0x%x", mask, fs.typ, remaining)
+		}
+		names = append(names, "0x"+strconv.FormatUint(remaining, 16))
+	}
+	return strings.Join(names, fs.separators[:1]), nil
+}
+
+func (fs *FlagSet) parseMask(value string) (uint64, error) {
+	tokens := strings.FieldsFunc(value, func(r rune) bool {
+		return strings.ContainsRune(fs.separators, r)
+	})
+
+	var mask uint64
+	for _, token := range tokens {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		negate := false
+		switch token[0] {
+		case '-':
+			negate = true
+			token = strings.TrimSpace(token[1:])
+		case '+':
+			token = strings.TrimSpace(token[1:])
+		}
+
+		bit, err := fs.lookup(token)
+		if err != nil {
+			return 0, err
+		}
+		if negate {
+			mask &^= bit
+		} else {
+			mask |= bit
+		}
+	}
+	return mask, nil
+}
+
+func (fs *FlagSet) lookup(token string) (uint64, error) {
+	if bit, ok := fs.flags[fs.key(token)]; ok {
+		return bit, nil
+	}
+	if fs.opts.AllowNumeric {
+		if bit, err := strconv.ParseUint(token, 0, fs.size); err == nil {
+			return bit, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown %s flag %q; valid flags: %s", fs.typ, token, strings.Join(fs.names, ", "))
+}
+
+func (fs *FlagSet) set(v reflect.Value, mask uint64) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Sign-extend so that the top bit of a narrow signed type is preserved
+		shift := 64 - fs.size
+		v.SetInt(int64(mask<<shift) >> shift)
+	default:
+		v.SetUint(mask)
+	}
+}
+
+func (fs *FlagSet) key(name string) string {
+	if fs.opts.CaseInsensitive {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// integerBits returns the bit size of an integer-kinded type
+func integerBits(t reflect.Type) (int, bool) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return t.Bits(), true
+	}
+	return 0, false
+}
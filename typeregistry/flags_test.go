@@ -0,0 +1,216 @@
+package typeregistry
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testPerm uint8
+
+const (
+	permRead testPerm = 1 << iota
+	permWrite
+	permExec
+)
+
+type testSignedFlags int8
+
+func permFlags() map[string]uint64 {
+	return map[string]uint64{
+		"read":  uint64(permRead),
+		"write": uint64(permWrite),
+		"exec":  uint64(permExec),
+		"all":   uint64(permRead | permWrite | permExec),
+		"none":  0,
+	}
+}
+
+// TestRegisterFlags tests parsing flag lists through the registry
+func TestRegisterFlags(t *testing.T) {
+	registry := NewTypeRegistry()
+	permType := reflect.TypeOf(permRead)
+
+	if _, err := registry.RegisterFlags(permType, permFlags(), FlagSetOptions{AllowNumeric: true}); err != nil {
+		t.Fatalf("RegisterFlags returned error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected testPerm
+		hasError bool
+	}{
+		{"single flag", "read", permRead, false},
+		{"pipe separated", "read|write|exec", permRead | permWrite | permExec, false},
+		{"comma separated", "read,write", permRead | permWrite, false},
+		{"spaces around names", " read , exec ", permRead | permExec, false},
+		{"negation", "all,-exec", permRead | permWrite, false},
+		{"explicit plus", "+write", permWrite, false},
+		{"negation order matters", "-exec,all", permRead | permWrite | permExec, false},
+		{"numeric fallback", "read|4", permRead | permExec, false},
+		{"hex fallback", "0x2", permWrite, false},
+		{"empty string", "", 0, false},
+		{"zero name", "none", 0, false},
+		{"unknown flag", "read|delete", 0, true},
+		{"numeric out of range", "256", 0, true},
+		{"case sensitive", "READ", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.Convert(tt.input, permType)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Convert(%q) expected error, got nil", tt.input)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Convert(%q) unexpected error: %v", tt.input, err)
+				}
+				if result != tt.expected {
+					t.Errorf("Convert(%q) = %v, expected %v", tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+
+	result, err := registry.Convert("write|exec", reflect.PointerTo(permType))
+	if err != nil {
+		t.Fatalf("pointer Convert unexpected error: %v", err)
+	}
+	if ptr, ok := result.(*testPerm); !ok || *ptr != permWrite|permExec {
+		t.Fatalf("expected *testPerm pointing at write|exec, got %v", result)
+	}
+}
+
+// TestFlagSetOptions tests custom separators and case-insensitive names
+func TestFlagSetOptions(t *testing.T) {
+	fs, err := NewFlagSet(reflect.TypeOf(permRead), permFlags(), FlagSetOptions{Separators: "+ ", CaseInsensitive: true})
+	if err != nil {
+		t.Fatalf("NewFlagSet returned error: %v", err)
+	}
+
+	result, err := fs.Parse("READ+Write exec")
+	if err != nil {
+		t.Fatalf("Parse unexpected error: %v", err)
+	}
+	if result != permRead|permWrite|permExec {
+		t.Fatalf("Parse = %v, expected all flags", result)
+	}
+
+	if _, err := fs.Parse("read,write"); err == nil {
+		t.Fatal("comma should not be a separator when custom separators are set")
+	}
+	if _, err := fs.Parse("4"); err == nil {
+		t.Fatal("numeric tokens should be rejected unless AllowNumeric is set")
+	}
+
+	formatted, err := fs.Format(permRead | permExec)
+	if err != nil {
+		t.Fatalf("Format unexpected error: %v", err)
+	}
+	if formatted != "read+exec" {
+		t.Fatalf("Format = %q, expected %q", formatted, "read+exec")
+	}
+}
+
+// TestFlagSetFormat tests formatting values back to canonical names
+func TestFlagSetFormat(t *testing.T) {
+	fs, err := NewFlagSet(reflect.TypeOf(permRead), permFlags(), FlagSetOptions{})
+	if err != nil {
+		t.Fatalf("NewFlagSet returned error: %v", err)
+	}
+
+	tests := []struct {
+		value    testPerm
+		expected string
+		hasError bool
+	}{
+		{permRead, "read", false},
+		{permExec | permRead, "read,exec", false},
+		{permRead | permWrite | permExec, "read,write,exec", false},
+		{0, "none", false},
+		{0x10, "", true},
+	}
+
+	for _, tt := range tests {
+		formatted, err := fs.Format(tt.value)
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("Format(%v) expected error, got nil", tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Format(%v) unexpected error: %v", tt.value, err)
+		}
+		if formatted != tt.expected {
+			t.Errorf("Format(%v) = %q, expected %q", tt.value, formatted, tt.expected)
+		}
+
+		// Formatted values must parse back to the same value
+		parsed, err := fs.Parse(formatted)
+		if err != nil || parsed != tt.value {
+			t.Errorf("Parse(Format(%v)) = %v, %v", tt.value, parsed, err)
+		}
+	}
+
+	numeric, err := NewFlagSet(reflect.TypeOf(permRead), permFlags(), FlagSetOptions{AllowNumeric: true})
+	if err != nil {
+		t.Fatalf("NewFlagSet returned error: %v", err)
+	}
+	formatted, err := numeric.Format(permRead | 0x30)
+	if err != nil || formatted != "read,0x30" {
+		t.Fatalf("Format with unnamed bits = %q, %v; expected %q", formatted, err, "read,0x30")
+	}
+
+	if _, err := fs.Format(uint8(1)); err == nil {
+		t.Fatal("Format should reject values of a different type")
+	}
+}
+
+// TestFlagSetSigned tests that the sign bit of a narrow signed type can be used as a flag
+func TestFlagSetSigned(t *testing.T) {
+	fs, err := NewFlagSet(reflect.TypeOf(testSignedFlags(0)), map[string]uint64{"low": 0x01, "high": 0x80}, FlagSetOptions{})
+	if err != nil {
+		t.Fatalf("NewFlagSet returned error: %v", err)
+	}
+
+	result, err := fs.Parse("low|high")
+	if err != nil {
+		t.Fatalf("Parse unexpected error: %v", err)
+	}
+	if result != testSignedFlags(-127) {
+		t.Fatalf("Parse = %v, expected -127", result)
+	}
+
+	formatted, err := fs.Format(result)
+	if err != nil || formatted != "low,high" {
+		t.Fatalf("Format = %q, %v; expected %q", formatted, err, "low,high")
+	}
+}
+
+// TestNewFlagSetInvalid tests that bad definitions are rejected
+func TestNewFlagSetInvalid(t *testing.T) {
+	permType := reflect.TypeOf(permRead)
+
+	if _, err := NewFlagSet(reflect.TypeOf(""), permFlags(), FlagSetOptions{}); err == nil {
+		t.Fatal("expected error for non-integer type")
+	}
+	if _, err := NewFlagSet(permType, nil, FlagSetOptions{}); err == nil {
+		t.Fatal("expected error for empty flag set")
+	}
+	if _, err := NewFlagSet(permType, map[string]uint64{"big": 0x100}, FlagSetOptions{}); err == nil {
+		t.Fatal("expected error for flag that does not fit the type")
+	}
+	if _, err := NewFlagSet(permType, map[string]uint64{"a,b": 1}, FlagSetOptions{}); err == nil {
+		t.Fatal("expected error for name containing a separator")
+	}
+	if _, err := NewFlagSet(permType, map[string]uint64{"-a": 1}, FlagSetOptions{}); err == nil {
+		t.Fatal("expected error for name starting with a sign")
+	}
+	if _, err := NewFlagSet(permType, map[string]uint64{"Read": 1, "read": 2}, FlagSetOptions{CaseInsensitive: true}); err == nil {
+		t.Fatal("expected error for names colliding case-insensitively")
+	}
+}
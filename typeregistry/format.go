@@ -0,0 +1,127 @@
+package typeregistry
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// FormatRegistry holds formatters, the inverse of the converters held by TypeRegistry
+type FormatRegistry struct {
+	formatters map[reflect.Type]model.FormatterFunc
+}
+
+// NewFormatRegistry creates an empty format registry
+func NewFormatRegistry() *FormatRegistry {
+	return &FormatRegistry{
+		formatters: make(map[reflect.Type]model.FormatterFunc),
+	}
+}
+
+// RegisterFormatter adds a formatter for values of sourceType
+func (fr *FormatRegistry) RegisterFormatter(sourceType reflect.Type, formatter model.FormatterFunc) {
+	fr.formatters[sourceType] = formatter
+}
+
+func (fr *FormatRegistry) RegisterAllFormatters(formatters map[reflect.Type]model.FormatterFunc) {
+	for sourceType, formatter := range formatters {
+		fr.RegisterFormatter(sourceType, formatter)
+	}
+}
+
+// GetFormatter retrieves a formatter for the given type. Registered formatters come first,
+// then encoding.TextMarshaler and fmt.Stringer implementations, then the formatter for the
+// element of a pointer type and finally a formatter derived from a scalar kind. Nil pointers
+// format as "", and a nil type has no formatter.
+func (fr *FormatRegistry) GetFormatter(sourceType reflect.Type) (model.FormatterFunc, bool) {
+	if sourceType == nil {
+		return nil, false
+	}
+	if formatter, exists := fr.formatters[sourceType]; exists {
+		return formatter, true
+	}
+	if sourceType.Implements(textMarshalerType) {
+		return skipNil(sourceType, marshalText), true
+	}
+	if sourceType.Implements(stringerType) {
+		return skipNil(sourceType, formatStringer), true
+	}
+	if sourceType.Kind() == reflect.Pointer {
+		formatter, exists := fr.GetFormatter(sourceType.Elem())
+		if !exists {
+			return nil, false
+		}
+		return skipNil(sourceType, func(value interface{}) (string, error) {
+			return formatter(reflect.ValueOf(value).Elem().Interface())
+		}), true
+	}
+	if isScalarKind(sourceType.Kind()) {
+		return formatKind, true
+	}
+	return nil, false
+}
+
+// Format formats value with the formatter for its dynamic type; nil formats as ""
+func (fr *FormatRegistry) Format(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	if formatter, exists := fr.GetFormatter(reflect.TypeOf(value)); exists {
+		return formatter(value)
+	}
+
+	return "", fmt.Errorf("no formatter registered for type: %T", value)
+}
+
+// skipNil wraps formatter so that nil pointers of sourceType format as ""
+func skipNil(sourceType reflect.Type, formatter model.FormatterFunc) model.FormatterFunc {
+	if sourceType.Kind() != reflect.Pointer {
+		return formatter
+	}
+	return func(value interface{}) (string, error) {
+		if reflect.ValueOf(value).IsNil() {
+			return "", nil
+		}
+		return formatter(value)
+	}
+}
+
+func marshalText(value interface{}) (string, error) {
+	text, err := value.(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+func formatStringer(value interface{}) (string, error) {
+	return value.(fmt.Stringer).String(), nil
+}
+
+// formatKind formats any scalar kind, including named types, in the form setKind parses
+func formatKind(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("cannot format %T", value)
+}
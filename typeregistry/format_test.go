@@ -0,0 +1,125 @@
+package typeregistry
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+type testSuit int
+
+func (c testSuit) String() string {
+	return [...]string{"hearts", "spades"}[c]
+}
+
+type testVersion struct {
+	major, minor int
+}
+
+func (v *testVersion) String() string {
+	return fmt.Sprintf("v%d.%d", v.major, v.minor)
+}
+
+type testToken string
+
+func (tok testToken) MarshalText() ([]byte, error) {
+	if tok == "" {
+		return nil, errors.New("empty token")
+	}
+	return []byte("tok-" + string(tok)), nil
+}
+
+func (tok testToken) String() string {
+	return "ignored"
+}
+
+// TestFormatRegistryRegisterFormatter tests that registered formatters take precedence
+func TestFormatRegistryRegisterFormatter(t *testing.T) {
+	registry := NewFormatRegistry()
+	registry.RegisterFormatter(reflect.TypeOf(testSuit(0)), func(value interface{}) (string, error) {
+		return "custom", nil
+	})
+
+	result, err := registry.Format(testSuit(1))
+	if err != nil {
+		t.Fatalf("Format unexpected error: %v", err)
+	}
+	if result != "custom" {
+		t.Errorf("Format = %q, expected %q", result, "custom")
+	}
+
+	registry.RegisterAllFormatters(map[reflect.Type]model.FormatterFunc{})
+	if _, exists := registry.GetFormatter(reflect.TypeOf(testSuit(0))); !exists {
+		t.Error("formatter should still be registered")
+	}
+}
+
+// TestFormatRegistryFallbacks tests formatting without registered formatters
+func TestFormatRegistryFallbacks(t *testing.T) {
+	registry := NewFormatRegistry()
+	level := int8(-3)
+	var nilIP *net.IP
+	var nilLevel *int8
+	var nilVersion *testVersion
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"text marshaler before stringer", testToken("a"), "tok-a"},
+		{"stringer", testSuit(1), "spades"},
+		{"pointer stringer", &testVersion{1, 2}, "v1.2"},
+		{"nil pointer stringer", nilVersion, ""},
+		{"library text marshaler", net.IPv4(10, 0, 0, 1), "10.0.0.1"},
+		{"nil pointer text marshaler", nilIP, ""},
+		{"pointer to scalar", &level, "-3"},
+		{"nil pointer to scalar", nilLevel, ""},
+		{"bool", true, "true"},
+		{"named string", testName("x"), "x"},
+		{"uintptr", uintptr(7), "7"},
+		{"float32", testRatio(0.1), "0.1"},
+		{"complex64", complex64(complex(1, 2)), "(1+2i)"},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "2024-01-02T03:04:05Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.Format(tt.input)
+			if err != nil {
+				t.Errorf("Format(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatRegistryErrors tests unformattable and nil types and failing marshalers
+func TestFormatRegistryErrors(t *testing.T) {
+	registry := NewFormatRegistry()
+
+	if _, err := registry.Format(struct{}{}); err == nil {
+		t.Error("Format(struct{}{}) expected error, got nil")
+	}
+	if _, err := registry.Format([]int{1}); err == nil {
+		t.Error("Format([]int{1}) expected error, got nil")
+	}
+	if _, exists := registry.GetFormatter(reflect.TypeOf(new(struct{}))); exists {
+		t.Error("pointers to unformattable types should not have a formatter")
+	}
+	if formatter, exists := registry.GetFormatter(nil); exists || formatter != nil {
+		t.Errorf("GetFormatter(nil) = %v, %v; expected no formatter", formatter, exists)
+	}
+	if _, err := registry.Format(testToken("")); err == nil {
+		t.Error("Format(testToken(\"\")) expected error, got nil")
+	}
+}
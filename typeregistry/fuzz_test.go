@@ -0,0 +1,119 @@
+package typeregistry_test
+
+import (
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/converter"
+	"github.com/dheeraj-sn/str2go/globalregistry"
+	"github.com/dheeraj-sn/str2go/model"
+	"github.com/dheeraj-sn/str2go/str2gotest"
+	"github.com/dheeraj-sn/str2go/typeregistry"
+)
+
+type fuzzLevel int8
+
+type fuzzName string
+
+// fuzzTypes maps type names to the default converter types and to named kinds handled by
+// EnableCoverage; seeds name their target type so that new converters do not change them
+var fuzzTypes = typesByName(converter.GetConvertorMap(),
+	reflect.TypeOf(fuzzLevel(0)), reflect.TypeOf(fuzzName("")), reflect.TypeOf(new(fuzzLevel)))
+
+func typesByName(converters map[reflect.Type]model.ConverterFunc, extra ...reflect.Type) map[string]reflect.Type {
+	types := make(map[string]reflect.Type, len(converters)+len(extra))
+	for t := range converters {
+		types[t.String()] = t
+	}
+	for _, t := range extra {
+		types[t.String()] = t
+	}
+	return types
+}
+
+// textEqual compares types whose parsed form keeps details that formatting does not
+// preserve, such as the raw path of a URL, by their formatted text
+var textEqual = map[reflect.Type]bool{
+	reflect.TypeOf(url.URL{}):        true,
+	reflect.TypeOf(&url.URL{}):       true,
+	reflect.TypeOf(regexp.Regexp{}):  true,
+	reflect.TypeOf(&regexp.Regexp{}): true,
+}
+
+func sameText(a, b interface{}) bool {
+	textA, errA := globalregistry.Format(a)
+	textB, errB := globalregistry.Format(b)
+	return errA == nil && errB == nil && textA == textB
+}
+
+// FuzzTypeRegistryConvert fuzzes Convert and ConvertInto on registries with the default
+// converters, with and without coverage and null tokens enabled. ConvertInto must store the
+// exact target type and round-trip through the default formatters, Convert must agree with
+// the registered converter, and neither may panic.
+func FuzzTypeRegistryConvert(f *testing.F) {
+	defaults := converter.GetConvertorMap()
+	plain := typeregistry.NewTypeRegistry()
+	plain.RegisterAll(defaults)
+	lenient := typeregistry.NewTypeRegistry()
+	lenient.RegisterAll(defaults)
+	lenient.EnableCoverage(typeregistry.CoverageOptions{})
+	nulls := typeregistry.DefaultNullOptions()
+	lenient.EnableNulls(nulls)
+
+	f.Fuzz(func(t *testing.T, typeName, input string) {
+		targetType, ok := fuzzTypes[typeName]
+		if !ok {
+			t.Skipf("no converter type named %q", typeName)
+		}
+
+		if convert, ok := defaults[targetType]; ok {
+			expected, expectedErr := convert(input)
+			result, err := plain.Convert(input, targetType)
+			if (err != nil) != (expectedErr != nil) || reflect.TypeOf(result) != reflect.TypeOf(expected) {
+				t.Fatalf("Convert(%q, %s) = %T, %v; the converter returned %T, %v", input, targetType, result, err, expected, expectedErr)
+			}
+		}
+
+		opts := str2gotest.Options{}
+		if textEqual[targetType] {
+			opts.Equal = sameText
+		}
+		str2gotest.CheckInput(t, convertInto(plain, targetType), targetType, opts, input)
+
+		if !isNullToken(nulls, input) {
+			str2gotest.CheckInput(t, convertInto(lenient, targetType), targetType, opts, input)
+			return
+		}
+		// Null tokens give nil pointers and zero values, which need not format back to a token
+		result, err := convertInto(lenient, targetType)(input)
+		if err != nil {
+			t.Fatalf("ConvertInto(%q) unexpected error for a null token: %v", input, err)
+		}
+		if zero := reflect.Zero(targetType).Interface(); !reflect.DeepEqual(result, zero) {
+			t.Fatalf("ConvertInto(%q) = %v, expected the zero %s", input, result, targetType)
+		}
+	})
+}
+
+func isNullToken(opts typeregistry.NullOptions, value string) bool {
+	for _, token := range opts.Tokens {
+		if value == token || (opts.CaseInsensitive && strings.EqualFold(value, token)) {
+			return true
+		}
+	}
+	return false
+}
+
+// convertInto adapts ConvertInto to a ConverterFunc returning the stored value
+func convertInto(registry *typeregistry.TypeRegistry, targetType reflect.Type) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		dest := reflect.New(targetType)
+		if err := registry.ConvertInto(value, dest.Interface()); err != nil {
+			return nil, err
+		}
+		return dest.Elem().Interface(), nil
+	}
+}
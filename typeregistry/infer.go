@@ -0,0 +1,145 @@
+package typeregistry
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Confidence describes how certain an inference is
+type Confidence int
+
+const (
+	// ConfidenceFallback means only string candidates, which accept any value, matched
+	ConfidenceFallback Confidence = iota
+	// ConfidenceAmbiguous means candidates with different meanings matched, such as bool and
+	// int64 for "1"; the first in priority order was chosen
+	ConfidenceAmbiguous
+	// ConfidenceCertain means a single candidate matched, apart from wider numeric types,
+	// such as float64 for "42", and string candidates
+	ConfidenceCertain
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceFallback:
+		return "fallback"
+	case ConfidenceAmbiguous:
+		return "ambiguous"
+	case ConfidenceCertain:
+		return "certain"
+	}
+	return fmt.Sprintf("Confidence(%d)", int(c))
+}
+
+// InferOptions configures Infer
+type InferOptions struct {
+	// Candidates are the types tried, narrowest first; the first that converts the value is
+	// chosen. Every candidate needs a converter in the registry.
+	Candidates []reflect.Type
+}
+
+// DefaultInferOptions returns the candidates bool, int64, float64, time.Time, time.Duration
+// and string, in that order
+func DefaultInferOptions() InferOptions {
+	return InferOptions{
+		Candidates: []reflect.Type{
+			reflect.TypeOf(false),
+			reflect.TypeOf(int64(0)),
+			reflect.TypeOf(float64(0)),
+			reflect.TypeOf(time.Time{}),
+			reflect.TypeOf(time.Duration(0)),
+			reflect.TypeOf(""),
+		},
+	}
+}
+
+// Inference is the result of Infer
+type Inference struct {
+	// Type is the first candidate that converts the value, or nil for null tokens
+	Type reflect.Type
+	// Value is the value converted to Type
+	Value interface{}
+	// Matches lists every candidate that converts the value, in priority order
+	Matches []reflect.Type
+	// Confidence tells whether other candidates with a different meaning also matched
+	Confidence Confidence
+	// Null reports that the value is a null token enabled by EnableNulls
+	Null bool
+}
+
+// SetInferOptions replaces the candidates used by Infer
+func (tr *TypeRegistry) SetInferOptions(opts InferOptions) {
+	tr.infer = &opts
+}
+
+// Infer guesses the narrowest type for value by converting it to each candidate in priority
+// order, DefaultInferOptions unless SetInferOptions was called. Null tokens, when enabled, give
+// an Inference with Null set and no type.
+func (tr *TypeRegistry) Infer(value string) (Inference, error) {
+	opts := DefaultInferOptions()
+	if tr.infer != nil {
+		opts = *tr.infer
+	}
+	if tr.nulls != nil && tr.nulls.isNull(value) {
+		return Inference{Null: true, Confidence: ConfidenceCertain}, nil
+	}
+
+	var inference Inference
+	families := map[reflect.Type]bool{}
+	for _, candidate := range opts.Candidates {
+		// Null tokens were handled above, so candidates use the converters without them
+		converter, exists := tr.lookup(candidate)
+		if !exists {
+			return Inference{}, fmt.Errorf("no converter registered for candidate type: %s", candidate)
+		}
+		result, err := converter(value)
+		if err != nil {
+			continue
+		}
+		exact, err := exactValue(result, candidate)
+		if err != nil {
+			continue
+		}
+
+		inference.Matches = append(inference.Matches, candidate)
+		if inference.Type == nil {
+			inference.Type = candidate
+			inference.Value = exact
+		}
+		if candidate.Kind() != reflect.String {
+			families[inferFamily(candidate)] = true
+		}
+	}
+
+	if inference.Type == nil {
+		return Inference{}, fmt.Errorf("no candidate type accepts %q", value)
+	}
+	switch {
+	case len(families) > 1:
+		inference.Confidence = ConfidenceAmbiguous
+	case len(families) == 1:
+		inference.Confidence = ConfidenceCertain
+	default:
+		inference.Confidence = ConfidenceFallback
+	}
+	return inference, nil
+}
+
+// numberFamily stands for every unnamed integer and float type, which are wider or narrower
+// forms of the same number rather than competing meanings
+var numberFamily = reflect.TypeOf(float64(0))
+
+// inferFamily groups candidates that give a value the same meaning
+func inferFamily(t reflect.Type) reflect.Type {
+	if t.PkgPath() != "" {
+		return t
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return numberFamily
+	}
+	return t
+}
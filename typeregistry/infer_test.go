@@ -0,0 +1,164 @@
+package typeregistry
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dheeraj-sn/str2go/converter"
+)
+
+func newInferTestRegistry() *TypeRegistry {
+	registry := NewTypeRegistry()
+	registry.RegisterAll(converter.GetConvertorMap())
+	return registry
+}
+
+// TestInferDefaultCandidates tests the type, value and confidence inferred for common inputs
+func TestInferDefaultCandidates(t *testing.T) {
+	registry := newInferTestRegistry()
+
+	tests := []struct {
+		input      string
+		expected   interface{}
+		confidence Confidence
+		matches    int
+	}{
+		{"true", true, ConfidenceCertain, 2},
+		{"1", true, ConfidenceAmbiguous, 4},
+		{"42", int64(42), ConfidenceCertain, 3},
+		{"-9223372036854775808", int64(-9223372036854775808), ConfidenceCertain, 3},
+		{"9223372036854775808", float64(9223372036854775808), ConfidenceCertain, 2},
+		{"2.5", 2.5, ConfidenceCertain, 2},
+		{"2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), ConfidenceCertain, 2},
+		{"1h30m", 90 * time.Minute, ConfidenceCertain, 2},
+		{"0", false, ConfidenceAmbiguous, 5},
+		{"hello", "hello", ConfidenceFallback, 1},
+		{"", "", ConfidenceFallback, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			inference, err := registry.Infer(tt.input)
+			if err != nil {
+				t.Fatalf("Infer(%q) unexpected error: %v", tt.input, err)
+			}
+			if inference.Type != reflect.TypeOf(tt.expected) || !reflect.DeepEqual(inference.Value, tt.expected) {
+				t.Errorf("Infer(%q) = %s %v, expected %T %v", tt.input, inference.Type, inference.Value, tt.expected, tt.expected)
+			}
+			if inference.Confidence != tt.confidence {
+				t.Errorf("Infer(%q) confidence = %v, expected %v", tt.input, inference.Confidence, tt.confidence)
+			}
+			if len(inference.Matches) != tt.matches {
+				t.Errorf("Infer(%q) matches = %v, expected %d", tt.input, inference.Matches, tt.matches)
+			}
+			if inference.Null {
+				t.Errorf("Infer(%q) should not be null", tt.input)
+			}
+		})
+	}
+}
+
+// TestInferCandidateOrder tests that custom candidates are tried in the given order
+func TestInferCandidateOrder(t *testing.T) {
+	registry := newInferTestRegistry()
+	registry.SetInferOptions(InferOptions{Candidates: []reflect.Type{
+		reflect.TypeOf(int8(0)),
+		reflect.TypeOf(int64(0)),
+		reflect.TypeOf(false),
+		reflect.TypeOf(""),
+	}})
+
+	// Legacy int64 results are narrowed to the candidate type
+	inference, err := registry.Infer("1")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if inference.Value != int8(1) || inference.Confidence != ConfidenceAmbiguous {
+		t.Errorf("Infer(%q) = %T %v (%v), expected int8 1 (ambiguous)", "1", inference.Value, inference.Value, inference.Confidence)
+	}
+
+	inference, err = registry.Infer("300")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if inference.Value != int64(300) || inference.Confidence != ConfidenceCertain {
+		t.Errorf("Infer(%q) = %T %v (%v), expected int64 300 (certain)", "300", inference.Value, inference.Value, inference.Confidence)
+	}
+}
+
+// TestInferNamedNumbers tests that named numeric types compete with plain numbers
+func TestInferNamedNumbers(t *testing.T) {
+	registry := newInferTestRegistry()
+	registry.SetInferOptions(InferOptions{Candidates: []reflect.Type{
+		reflect.TypeOf(int64(0)),
+		reflect.TypeOf(converter.Percent(0)),
+	}})
+
+	inference, err := registry.Infer("0.5")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if inference.Value != converter.Percent(0.5) || inference.Confidence != ConfidenceCertain {
+		t.Errorf("Infer(%q) = %v (%v), expected 50%% (certain)", "0.5", inference.Value, inference.Confidence)
+	}
+
+	inference, err = registry.Infer("2")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if inference.Value != int64(2) || inference.Confidence != ConfidenceAmbiguous {
+		t.Errorf("Infer(%q) = %v (%v), expected 2 (ambiguous)", "2", inference.Value, inference.Confidence)
+	}
+}
+
+// TestInferNulls tests that null tokens are reported without a type
+func TestInferNulls(t *testing.T) {
+	registry := newInferTestRegistry()
+	registry.EnableNulls(DefaultNullOptions())
+
+	inference, err := registry.Infer("n/a")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if !inference.Null || inference.Type != nil || inference.Value != nil {
+		t.Errorf("Infer(%q) = %+v, expected a null inference", "n/a", inference)
+	}
+
+	// Other values are inferred as usual
+	inference, err = registry.Infer("7")
+	if err != nil {
+		t.Fatalf("Infer unexpected error: %v", err)
+	}
+	if inference.Null || inference.Value != int64(7) {
+		t.Errorf("Infer(%q) = %+v, expected int64 7", "7", inference)
+	}
+}
+
+// TestInferErrors tests candidates without converters and values no candidate accepts
+func TestInferErrors(t *testing.T) {
+	registry := newInferTestRegistry()
+
+	registry.SetInferOptions(InferOptions{Candidates: []reflect.Type{reflect.TypeOf(struct{}{})}})
+	if _, err := registry.Infer("x"); err == nil {
+		t.Error("Infer expected error for a candidate without a converter, got nil")
+	}
+
+	registry.SetInferOptions(InferOptions{Candidates: []reflect.Type{reflect.TypeOf(false), reflect.TypeOf(int64(0))}})
+	if _, err := registry.Infer("x"); err == nil {
+		t.Error("Infer expected error when no candidate accepts the value, got nil")
+	}
+}
+
+func TestConfidenceString(t *testing.T) {
+	for confidence, expected := range map[Confidence]string{
+		ConfidenceFallback:  "fallback",
+		ConfidenceAmbiguous: "ambiguous",
+		ConfidenceCertain:   "certain",
+		Confidence(7):       "Confidence(7)",
+	} {
+		if result := confidence.String(); result != expected {
+			t.Errorf("Confidence(%d).String() = %q, expected %q", int(confidence), result, expected)
+		}
+	}
+}
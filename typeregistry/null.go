@@ -0,0 +1,87 @@
+package typeregistry
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// ErrNullNotAllowed is returned, wrapped in a *model.ConversionError, when a null token is
+// converted to a value type under NullError
+var ErrNullNotAllowed = errors.New("null not allowed")
+
+// NullPolicy selects what value targets receive for a null token
+type NullPolicy int
+
+const (
+	// NullZero converts null tokens to the zero value of value types
+	NullZero NullPolicy = iota
+	// NullError rejects null tokens for value types with ErrNullNotAllowed
+	NullError
+)
+
+// NullOptions configures the null handling enabled by EnableNulls
+type NullOptions struct {
+	// Tokens are the inputs that mean "no value", such as "", "NULL" or "NA"
+	Tokens []string
+	// CaseInsensitive matches tokens regardless of case, so "null" matches "NULL"
+	CaseInsensitive bool
+	// Policy decides what value targets receive; pointer, slice, map and interface
+	// targets always receive nil, and sql.Scanner types such as sql.NullInt64 their
+	// zero value, which is their NULL
+	Policy NullPolicy
+}
+
+// DefaultNullOptions returns the tokens commonly used for missing values in CSV data:
+// the empty string, "NULL", "NA", "N/A" and "-", matched case-insensitively
+func DefaultNullOptions() NullOptions {
+	return NullOptions{
+		Tokens:          []string{"", "NULL", "NA", "N/A", "-"},
+		CaseInsensitive: true,
+	}
+}
+
+// EnableNulls makes every converter returned by Get and used by Convert recognize null
+// tokens before parsing. Pointer, slice, map and interface targets convert them to nil,
+// and value targets to their zero value or an error, depending on opts.Policy.
+func (tr *TypeRegistry) EnableNulls(opts NullOptions) {
+	tr.nulls = &opts
+}
+
+// isNull reports whether value is one of the configured null tokens
+func (opts NullOptions) isNull(value string) bool {
+	for _, token := range opts.Tokens {
+		if value == token || (opts.CaseInsensitive && strings.EqualFold(value, token)) {
+			return true
+		}
+	}
+	return false
+}
+
+// nullable wraps converter so that null tokens produce nil or the zero value of t
+func (opts NullOptions) nullable(t reflect.Type, converter model.ConverterFunc) model.ConverterFunc {
+	return func(value string) (interface{}, error) {
+		if !opts.isNull(value) {
+			return converter(value)
+		}
+		if !isNullable(t) && opts.Policy == NullError {
+			return nil, &model.ConversionError{Value: value, Type: t, Offset: -1, Err: ErrNullNotAllowed}
+		}
+		return reflect.Zero(t).Interface(), nil
+	}
+}
+
+// scannerType matches sql.Scanner without importing database/sql
+var scannerType = reflect.TypeOf((*interface{ Scan(src any) error })(nil)).Elem()
+
+// isNullable reports whether the zero value of t represents null: nil for pointer, slice,
+// map and interface kinds, and NULL for sql.Scanner types such as sql.NullInt64
+func isNullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+	return reflect.PointerTo(t).Implements(scannerType)
+}
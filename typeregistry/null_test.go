@@ -0,0 +1,179 @@
+package typeregistry
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+func newNullTestRegistry(opts NullOptions) *TypeRegistry {
+	registry := NewTypeRegistry()
+	registry.Register(reflect.TypeOf(0), func(value string) (interface{}, error) {
+		return strconv.Atoi(value)
+	})
+	registry.Register(reflect.TypeOf(new(int)), func(value string) (interface{}, error) {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		return &i, nil
+	})
+	registry.Register(reflect.TypeOf(""), func(value string) (interface{}, error) {
+		return value, nil
+	})
+	registry.Register(reflect.TypeOf([]string{}), func(value string) (interface{}, error) {
+		return []string{value}, nil
+	})
+	registry.Register(reflect.TypeOf(map[string]string{}), func(value string) (interface{}, error) {
+		return map[string]string{"value": value}, nil
+	})
+	registry.Register(reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), func(value string) (interface{}, error) {
+		return nil, errors.New("not implemented")
+	})
+	registry.EnableNulls(opts)
+	return registry
+}
+
+// TestNullsDisabled tests that null tokens are ordinary input by default
+func TestNullsDisabled(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.Register(reflect.TypeOf(new(int)), func(value string) (interface{}, error) {
+		i, err := strconv.Atoi(value)
+		return &i, err
+	})
+
+	if _, err := registry.Convert("", reflect.TypeOf(new(int))); err == nil {
+		t.Error("empty input should fail without EnableNulls")
+	}
+}
+
+// TestNullsNilableTargets tests that pointer, slice, map and interface targets get typed nil
+func TestNullsNilableTargets(t *testing.T) {
+	registry := newNullTestRegistry(DefaultNullOptions())
+
+	targets := []reflect.Type{
+		reflect.TypeOf(new(int)),
+		reflect.TypeOf([]string{}),
+		reflect.TypeOf(map[string]string{}),
+	}
+	for _, token := range []string{"", "NULL", "null", "NA", "n/a", "-"} {
+		for _, targetType := range targets {
+			result, err := registry.Convert(token, targetType)
+			if err != nil {
+				t.Errorf("Convert(%q, %s) unexpected error: %v", token, targetType, err)
+				continue
+			}
+			if reflect.TypeOf(result) != targetType || !reflect.ValueOf(result).IsNil() {
+				t.Errorf("Convert(%q, %s) = %#v, expected typed nil", token, targetType, result)
+			}
+		}
+
+		stringerType := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+		if result, err := registry.Convert(token, stringerType); err != nil || result != nil {
+			t.Errorf("Convert(%q, %s) = %v, %v; expected nil", token, stringerType, result, err)
+		}
+	}
+
+	// Other input still reaches the converter
+	result, err := registry.Convert("42", reflect.TypeOf(new(int)))
+	if err != nil || *result.(*int) != 42 {
+		t.Errorf("Convert(%q, *int) = %v, %v; expected 42", "42", result, err)
+	}
+}
+
+// TestNullsZeroPolicy tests that value targets get their zero value under NullZero
+func TestNullsZeroPolicy(t *testing.T) {
+	registry := newNullTestRegistry(DefaultNullOptions())
+
+	result, err := registry.Convert("NA", reflect.TypeOf(0))
+	if err != nil || result != 0 {
+		t.Errorf("Convert(%q, int) = %v, %v; expected 0", "NA", result, err)
+	}
+	result, err = registry.Convert("NULL", reflect.TypeOf(""))
+	if err != nil || result != "" {
+		t.Errorf("Convert(%q, string) = %q, %v; expected empty string", "NULL", result, err)
+	}
+}
+
+// TestNullsErrorPolicy tests that value targets reject nulls under NullError
+func TestNullsErrorPolicy(t *testing.T) {
+	opts := DefaultNullOptions()
+	opts.Policy = NullError
+	registry := newNullTestRegistry(opts)
+
+	result, err := registry.Convert("NULL", reflect.TypeOf(0))
+	if !errors.Is(err, ErrNullNotAllowed) {
+		t.Fatalf("Convert(%q, int) = %v, %v; expected ErrNullNotAllowed", "NULL", result, err)
+	}
+	var conversionErr *model.ConversionError
+	if !errors.As(err, &conversionErr) || conversionErr.Type != reflect.TypeOf(0) || conversionErr.Value != "NULL" {
+		t.Errorf("Convert(%q, int) error = %v, expected a *model.ConversionError for int", "NULL", err)
+	}
+
+	// Nilable targets still receive nil
+	result, err = registry.Convert("NULL", reflect.TypeOf(new(int)))
+	if err != nil || result.(*int) != nil {
+		t.Errorf("Convert(%q, *int) = %v, %v; expected nil", "NULL", result, err)
+	}
+}
+
+// TestNullsCustomTokens tests case-sensitive custom tokens
+func TestNullsCustomTokens(t *testing.T) {
+	registry := newNullTestRegistry(NullOptions{Tokens: []string{"NULL"}})
+
+	if result, err := registry.Convert("NULL", reflect.TypeOf(new(int))); err != nil || result.(*int) != nil {
+		t.Errorf("Convert(%q, *int) = %v, %v; expected nil", "NULL", result, err)
+	}
+	if _, err := registry.Convert("null", reflect.TypeOf(new(int))); err == nil {
+		t.Errorf("Convert(%q, *int) expected error with case-sensitive tokens", "null")
+	}
+	if _, err := registry.Convert("", reflect.TypeOf(new(int))); err == nil {
+		t.Errorf("Convert(%q, *int) expected error when the empty string is not a token", "")
+	}
+}
+
+// TestNullsWithGet tests that converters returned by Get handle nulls too
+func TestNullsWithGet(t *testing.T) {
+	registry := newNullTestRegistry(DefaultNullOptions())
+	registry.EnableCoverage(CoverageOptions{})
+
+	converter, exists := registry.Get(reflect.TypeOf(new(uint16)))
+	if !exists {
+		t.Fatal("coverage should provide a *uint16 converter")
+	}
+	if result, err := converter("-"); err != nil || result.(*uint16) != nil {
+		t.Errorf("*uint16 converter(%q) = %v, %v; expected nil", "-", result, err)
+	}
+	if result, err := converter("7"); err != nil || *result.(*uint16) != 7 {
+		t.Errorf("*uint16 converter(%q) = %v, %v; expected 7", "7", result, err)
+	}
+
+	if _, exists := registry.Get(reflect.TypeOf(struct{}{})); exists {
+		t.Error("nulls should not create converters for unregistered types")
+	}
+}
+
+// TestNullsScannerTypes tests that sql.Scanner types receive their NULL zero value under NullError
+func TestNullsScannerTypes(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.Register(reflect.TypeOf(sql.NullInt64{}), func(value string) (interface{}, error) {
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NullInt64{Int64: i, Valid: true}, nil
+	})
+	opts := DefaultNullOptions()
+	opts.Policy = NullError
+	registry.EnableNulls(opts)
+
+	result, err := registry.Convert("NULL", reflect.TypeOf(sql.NullInt64{}))
+	if err != nil || result != (sql.NullInt64{}) {
+		t.Errorf("Convert(%q, sql.NullInt64) = %v, %v; expected an invalid NullInt64", "NULL", result, err)
+	}
+}
@@ -0,0 +1,84 @@
+package typeregistry
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+var optionalValueType = reflect.TypeOf((*model.OptionalValue)(nil)).Elem()
+
+// optionalBase returns the wrapper type when t, or *t, implements model.OptionalValue
+func optionalBase(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem(), t.Elem().Kind() != reflect.Pointer && t.Implements(optionalValueType)
+	}
+	return t, reflect.PointerTo(t).Implements(optionalValueType)
+}
+
+// optionalConverter converts into an optional wrapper, or a pointer to one, using the
+// converter for the wrapped type. Null tokens mark the wrapper as null.
+func (tr *TypeRegistry) optionalConverter(t reflect.Type) (model.ConverterFunc, bool) {
+	base, ok := optionalBase(t)
+	if !ok {
+		return nil, false
+	}
+	elemType := reflect.New(base).Interface().(model.OptionalValue).ValueType()
+	elemConverter, ok := tr.Get(elemType)
+	if !ok {
+		return nil, false
+	}
+
+	return func(value string) (interface{}, error) {
+		ptr := reflect.New(base)
+		optional := ptr.Interface().(model.OptionalValue)
+		if tr.nulls != nil && tr.nulls.isNull(value) {
+			optional.SetNull()
+		} else {
+			result, err := elemConverter(value)
+			if err != nil {
+				return nil, err
+			}
+			elem, err := exactValue(result, elemType)
+			if err != nil {
+				return nil, &model.ConversionError{Value: value, Type: t, Offset: -1, Err: err}
+			}
+			if err := optional.SetValue(elem); err != nil {
+				return nil, &model.ConversionError{Value: value, Type: t, Offset: -1, Err: err}
+			}
+		}
+
+		if t.Kind() == reflect.Pointer {
+			return ptr.Interface(), nil
+		}
+		return ptr.Elem().Interface(), nil
+	}, true
+}
+
+// exactValue converts a converter result to exactly t. Some default converters return
+// wider types, such as int64 for int8, so in-range numbers are narrowed.
+func exactValue(result interface{}, t reflect.Type) (interface{}, error) {
+	if result == nil {
+		return reflect.Zero(t).Interface(), nil
+	}
+	v := reflect.ValueOf(result)
+	if v.Type() == t {
+		return result, nil
+	}
+
+	target := reflect.New(t).Elem()
+	switch {
+	case v.CanInt() && target.CanInt() && !target.OverflowInt(v.Int()):
+		target.SetInt(v.Int())
+	case v.CanUint() && target.CanUint() && !target.OverflowUint(v.Uint()):
+		target.SetUint(v.Uint())
+	case v.CanFloat() && target.CanFloat() && !target.OverflowFloat(v.Float()):
+		target.SetFloat(v.Float())
+	case v.Type().AssignableTo(t):
+		target.Set(v)
+	default:
+		return nil, fmt.Errorf("converter returned %s, which does not fit in %s", v.Type(), t)
+	}
+	return target.Interface(), nil
+}
@@ -0,0 +1,142 @@
+package typeregistry
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/dheeraj-sn/str2go/model"
+)
+
+// testOptional is a minimal model.OptionalValue implementation
+type testOptional struct {
+	value int16
+	null  bool
+	set   bool
+}
+
+func (o *testOptional) ValueType() reflect.Type { return reflect.TypeOf(int16(0)) }
+func (o *testOptional) SetNull()                { *o = testOptional{null: true, set: true} }
+func (o *testOptional) SetValue(v interface{}) error {
+	value, ok := v.(int16)
+	if !ok {
+		return fmt.Errorf("SetValue of %T on testOptional", v)
+	}
+	*o = testOptional{value: value, set: true}
+	return nil
+}
+
+// rejectingOptional is a model.OptionalValue that refuses every value
+type rejectingOptional struct {
+	testOptional
+}
+
+func (o *rejectingOptional) SetValue(v interface{}) error { return errors.New("value rejected") }
+
+func newOptionalTestRegistry() *TypeRegistry {
+	registry := NewTypeRegistry()
+	// Returns int64, like the default sized-integer converters
+	registry.Register(reflect.TypeOf(int16(0)), func(value string) (interface{}, error) {
+		return strconv.ParseInt(value, 10, 16)
+	})
+	return registry
+}
+
+// TestOptionalConvert tests conversion into optional wrappers and pointers to them
+func TestOptionalConvert(t *testing.T) {
+	registry := newOptionalTestRegistry()
+
+	result, err := registry.Convert("12", reflect.TypeOf(testOptional{}))
+	if err != nil {
+		t.Fatalf("Convert unexpected error: %v", err)
+	}
+	if o := result.(testOptional); !o.set || o.null || o.value != 12 {
+		t.Errorf("Convert(%q) = %+v, expected value 12", "12", o)
+	}
+
+	result, err = registry.Convert("-3", reflect.TypeOf(&testOptional{}))
+	if err != nil {
+		t.Fatalf("Convert unexpected error: %v", err)
+	}
+	if o := result.(*testOptional); o.value != -3 {
+		t.Errorf("Convert(%q) = %+v, expected value -3", "-3", o)
+	}
+
+	if _, err := registry.Convert("x", reflect.TypeOf(testOptional{})); err == nil {
+		t.Errorf("Convert(%q) expected error, got nil", "x")
+	}
+	// Errors from SetValue are conversion errors
+	_, err = registry.Convert("12", reflect.TypeOf(rejectingOptional{}))
+	var convErr *model.ConversionError
+	if !errors.As(err, &convErr) || convErr.Value != "12" {
+		t.Errorf("Convert(%q) into rejectingOptional error = %v, expected a ConversionError", "12", err)
+	}
+	if _, exists := NewTypeRegistry().Get(reflect.TypeOf(testOptional{})); exists {
+		t.Error("optional wrappers need a converter for the wrapped type")
+	}
+}
+
+// TestOptionalNulls tests that null tokens mark optional wrappers as null under any policy
+func TestOptionalNulls(t *testing.T) {
+	registry := newOptionalTestRegistry()
+	opts := DefaultNullOptions()
+	opts.Policy = NullError
+	registry.EnableNulls(opts)
+
+	for _, targetType := range []reflect.Type{reflect.TypeOf(testOptional{}), reflect.TypeOf(&testOptional{})} {
+		result, err := registry.Convert("NULL", targetType)
+		if err != nil {
+			t.Fatalf("Convert(%q, %s) unexpected error: %v", "NULL", targetType, err)
+		}
+		o := reflect.ValueOf(result)
+		if o.Kind() == reflect.Pointer {
+			o = o.Elem()
+		}
+		if got := o.Interface().(testOptional); !got.set || !got.null {
+			t.Errorf("Convert(%q, %s) = %+v, expected null", "NULL", targetType, got)
+		}
+	}
+
+	// The wrapped type itself still rejects nulls
+	if _, err := registry.Convert("NULL", reflect.TypeOf(int16(0))); !errors.Is(err, ErrNullNotAllowed) {
+		t.Errorf("Convert(%q, int16) error = %v, expected ErrNullNotAllowed", "NULL", err)
+	}
+}
+
+// TestExactValue tests narrowing of converter results to the wrapped type
+func TestExactValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     interface{}
+		targetType reflect.Type
+		expected   interface{}
+		hasError   bool
+	}{
+		{"same type", int16(5), reflect.TypeOf(int16(0)), int16(5), false},
+		{"narrow int", int64(-5), reflect.TypeOf(int8(0)), int8(-5), false},
+		{"int out of range", int64(300), reflect.TypeOf(int8(0)), nil, true},
+		{"narrow uint", uint64(255), reflect.TypeOf(uint8(0)), uint8(255), false},
+		{"uint out of range", uint64(256), reflect.TypeOf(uint8(0)), nil, true},
+		{"narrow float", float64(0.5), reflect.TypeOf(float32(0)), float32(0.5), false},
+		{"float out of range", float64(1e300), reflect.TypeOf(float32(0)), nil, true},
+		{"nil", nil, reflect.TypeOf(new(int)), (*int)(nil), false},
+		{"unrelated", "text", reflect.TypeOf(0), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := exactValue(tt.result, tt.targetType)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("exactValue(%v, %s) expected error, got %v", tt.result, tt.targetType, result)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("exactValue(%v, %s) = %#v, %v; expected %#v", tt.result, tt.targetType, result, err, tt.expected)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 // TypeRegistry holds all registered type converters
 type TypeRegistry struct {
 	converters map[reflect.Type]model.ConverterFunc
+	coverage   *CoverageOptions
+	nulls      *NullOptions
+	infer      *InferOptions
 }
 
 // NewTypeRegistry creates a new type registry with default converters
@@ -31,12 +34,42 @@ func (tr *TypeRegistry) RegisterAll(converters map[reflect.Type]model.ConverterF
 	}
 }
 
-// Get retrieves a converter for the given type
+// Get retrieves a converter for the given type. Optional wrappers such as
+// optional.Optional[T] are converted with the converter for T. With coverage enabled,
+// scalar kinds without a registered converter get one derived from their kind, and with
+// nulls enabled the converter recognizes null tokens.
 func (tr *TypeRegistry) Get(typeName reflect.Type) (model.ConverterFunc, bool) {
-	converter, exists := tr.converters[typeName]
+	converter, exists := tr.lookup(typeName)
+	if exists && tr.nulls != nil {
+		// Optional wrappers record null tokens themselves
+		if _, optional := optionalBase(typeName); !optional {
+			converter = tr.nulls.nullable(typeName, converter)
+		}
+	}
 	return converter, exists
 }
 
+// lookup finds the converter for typeName before null handling is applied
+func (tr *TypeRegistry) lookup(typeName reflect.Type) (model.ConverterFunc, bool) {
+	if typeName == nil {
+		return nil, false
+	}
+	converter, exists := tr.converters[typeName]
+	if exists {
+		return converter, true
+	}
+	if converter, ok := tr.optionalConverter(typeName); ok {
+		return converter, true
+	}
+	if tr.coverage != nil {
+		if converter, ok := charConverter(typeName, *tr.coverage); ok {
+			return converter, true
+		}
+		return kindConverter(typeName)
+	}
+	return nil, false
+}
+
 // Convert uses the registry to convert a string to the specified type
 func (tr *TypeRegistry) Convert(value string, targetType reflect.Type) (interface{}, error) {
 	// Check for exact match first
@@ -47,6 +80,32 @@ func (tr *TypeRegistry) Convert(value string, targetType reflect.Type) (interfac
 	return nil, fmt.Errorf("no converter registered for type: %s", targetType)
 }
 
+// ConvertInto converts value to the type dest points to and stores the result in *dest.
+// Results of a wider type, such as the int64 returned for int8 by the default converters,
+// are narrowed when they fit.
+func (tr *TypeRegistry) ConvertInto(value string, dest interface{}) error {
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dest)
+	}
+
+	elemType := target.Type().Elem()
+	result, err := tr.Convert(value, elemType)
+	if err != nil {
+		return err
+	}
+	exact, err := exactValue(result, elemType)
+	if err != nil {
+		return &model.ConversionError{Value: value, Type: elemType, Offset: -1, Err: err}
+	}
+	if exact == nil {
+		target.Elem().Set(reflect.Zero(elemType))
+	} else {
+		target.Elem().Set(reflect.ValueOf(exact))
+	}
+	return nil
+}
+
 // GetSupportedTypes returns all registered types
 func (tr *TypeRegistry) GetSupportedTypes() []reflect.Type {
 	types := make([]reflect.Type, 0, len(tr.converters))
@@ -137,6 +137,28 @@ func TestConvertWithError(t *testing.T) {
 	}
 }
 
+// TestConvertNilType tests that a nil type has no converter in every registry mode
+func TestConvertNilType(t *testing.T) {
+	registry := NewTypeRegistry()
+	registry.Register(reflect.TypeOf(""), func(value string) (interface{}, error) { return value, nil })
+
+	check := func(mode string) {
+		t.Helper()
+		if converter, exists := registry.Get(nil); exists || converter != nil {
+			t.Errorf("%s: Get(nil) = %v, %v; expected no converter", mode, converter, exists)
+		}
+		if _, err := registry.Convert("x", nil); err == nil {
+			t.Errorf("%s: Convert(%q, nil) expected error, got nil", mode, "x")
+		}
+	}
+
+	check("plain")
+	registry.EnableCoverage(CoverageOptions{})
+	check("coverage")
+	registry.EnableNulls(DefaultNullOptions())
+	check("coverage and nulls")
+}
+
 // TestGetSupportedTypes tests getting all supported types
 func TestGetSupportedTypes(t *testing.T) {
 	registry := NewTypeRegistry()
@@ -445,3 +467,41 @@ func TestRegisterAllEmptyMap(t *testing.T) {
 		t.Fatalf("expected %d converters after empty RegisterAll, got %d", initialCount, len(registry.converters))
 	}
 }
+
+// TestConvertInto tests storing converted values through a pointer
+func TestConvertInto(t *testing.T) {
+	registry := NewTypeRegistry()
+	// Returns int64, like the default sized-integer converters
+	registry.Register(reflect.TypeOf(int8(0)), func(value string) (interface{}, error) {
+		var i int64
+		_, err := fmt.Sscan(value, &i)
+		return i, err
+	})
+	registry.Register(reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), func(value string) (interface{}, error) {
+		return nil, nil
+	})
+
+	var small int8
+	if err := registry.ConvertInto("-12", &small); err != nil || small != -12 {
+		t.Errorf("ConvertInto(%q) = %d, %v; expected -12", "-12", small, err)
+	}
+	if err := registry.ConvertInto("300", &small); err == nil {
+		t.Errorf("ConvertInto(%q) into int8 expected error, got nil", "300")
+	}
+
+	var stringer fmt.Stringer
+	if err := registry.ConvertInto("x", &stringer); err != nil || stringer != nil {
+		t.Errorf("ConvertInto into fmt.Stringer = %v, %v; expected nil", stringer, err)
+	}
+
+	if err := registry.ConvertInto("1", small); err == nil {
+		t.Error("ConvertInto with a non-pointer destination should fail")
+	}
+	if err := registry.ConvertInto("1", (*int8)(nil)); err == nil {
+		t.Error("ConvertInto with a nil pointer should fail")
+	}
+	var unsupported float32
+	if err := registry.ConvertInto("1", &unsupported); err == nil {
+		t.Error("ConvertInto without a converter should fail")
+	}
+}